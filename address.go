@@ -0,0 +1,47 @@
+package ethunits
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// ChecksumAddress returns the EIP-55 mixed-case checksum encoding of a
+// hex-encoded, 0x-prefixed 20 byte address.
+// It returns false if addr isn't a valid address.
+func ChecksumAddress(addr string) (string, bool) {
+	if len(addr) != 42 || !strings.HasPrefix(addr, "0x") {
+		return "", false
+	}
+
+	lower := strings.ToLower(addr[2:])
+	if _, err := hex.DecodeString(lower); err != nil {
+		return "", false
+	}
+
+	h := sha3.NewLegacyKeccak256()
+	h.Write([]byte(lower))
+	hash := h.Sum(nil)
+
+	out := []byte(lower)
+	for i, c := range out {
+		nibble := hash[i/2]
+		if i%2 == 0 {
+			nibble >>= 4
+		}
+
+		if c >= 'a' && nibble&0xf >= 8 {
+			out[i] = c - 'a' + 'A'
+		}
+	}
+
+	return "0x" + string(out), true
+}
+
+// IsChecksumAddress reports whether addr is a valid address
+// in its EIP-55 checksum encoding.
+func IsChecksumAddress(addr string) bool {
+	checksummed, ok := ChecksumAddress(addr)
+	return ok && checksummed == addr
+}
@@ -0,0 +1,133 @@
+package ethunits
+
+import (
+	"errors"
+	"math/big"
+	"sort"
+)
+
+var (
+	ErrNegativeAmount = errors.New("amount must not be negative")
+	ErrInvalidWeights = errors.New("weights must be non-negative and not all zero")
+	ErrInvalidParts   = errors.New("number of parts must be positive")
+)
+
+// Allocator divides integer amounts, such as amounts of Wei, into parts
+// which always sum exactly to the divided amount.
+//
+// Each part is first rounded down, and the remaining units are handed out one
+// at a time in order of the largest fractional remainder, ties going to the
+// part with the lower index.
+type Allocator struct {
+	// MinShare is the dust threshold: parts which would be smaller are
+	// dropped to zero, smallest weight first, and their amount is reallocated
+	// among the remaining parts. The last remaining part is always kept.
+	// A nil MinShare keeps every part.
+	MinShare *big.Int
+}
+
+// Allocate divides total into parts proportional to weights.
+func (a Allocator) Allocate(total *big.Int, weights ...*big.Int) ([]*big.Int, error) {
+	if total.Sign() < 0 {
+		return nil, ErrNegativeAmount
+	}
+
+	var active []int
+	for i, w := range weights {
+		switch w.Sign() {
+		case -1:
+			return nil, ErrInvalidWeights
+		case 1:
+			active = append(active, i)
+		}
+	}
+
+	if len(active) == 0 {
+		return nil, ErrInvalidWeights
+	}
+
+	for {
+		parts := allocate(total, weights, active)
+		if a.MinShare == nil || len(active) == 1 {
+			return parts, nil
+		}
+
+		drop := -1
+		for j, i := range active {
+			if parts[i].Cmp(a.MinShare) >= 0 {
+				continue
+			}
+
+			if drop == -1 || weights[i].Cmp(weights[active[drop]]) <= 0 {
+				drop = j
+			}
+		}
+
+		if drop == -1 {
+			return parts, nil
+		}
+
+		active = append(active[:drop], active[drop+1:]...)
+	}
+}
+
+// Split divides total into n parts which differ by at most one unit,
+// the larger parts coming first.
+func (a Allocator) Split(total *big.Int, n int) ([]*big.Int, error) {
+	if n <= 0 {
+		return nil, ErrInvalidParts
+	}
+
+	weights := make([]*big.Int, n)
+	for i := range weights {
+		weights[i] = big.NewInt(1)
+	}
+
+	return a.Allocate(total, weights...)
+}
+
+// Allocate divides total into parts proportional to weights,
+// using the zero Allocator.
+func Allocate(total *big.Int, weights ...*big.Int) ([]*big.Int, error) {
+	return Allocator{}.Allocate(total, weights...)
+}
+
+// Split divides total into n parts which differ by at most one unit,
+// using the zero Allocator.
+func Split(total *big.Int, n int) ([]*big.Int, error) {
+	return Allocator{}.Split(total, n)
+}
+
+// allocate divides total among the weights at the active indices using the
+// largest remainder method. Parts at other indices are zero.
+func allocate(total *big.Int, weights []*big.Int, active []int) []*big.Int {
+	sum := new(big.Int)
+	for _, i := range active {
+		sum.Add(sum, weights[i])
+	}
+
+	parts := make([]*big.Int, len(weights))
+	for i := range parts {
+		parts[i] = new(big.Int)
+	}
+
+	remainders := make([]*big.Int, len(weights))
+	leftover := new(big.Int).Set(total)
+	for _, i := range active {
+		remainders[i] = new(big.Int)
+		parts[i].QuoRem(new(big.Int).Mul(total, weights[i]), sum, remainders[i])
+		leftover.Sub(leftover, parts[i])
+	}
+
+	order := append([]int(nil), active...)
+	sort.SliceStable(order, func(x, y int) bool {
+		return remainders[order[x]].Cmp(remainders[order[y]]) > 0
+	})
+
+	// leftover is less than len(active), since each part lost less than one unit.
+	for _, i := range order[:leftover.Int64()] {
+		parts[i].Add(parts[i], big.NewInt(1))
+	}
+
+	return parts
+}
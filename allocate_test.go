@@ -0,0 +1,107 @@
+package ethunits_test
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jalavosus/go-ethunits"
+)
+
+func bigInts(values ...int64) []*big.Int {
+	out := make([]*big.Int, len(values))
+	for i, v := range values {
+		out[i] = big.NewInt(v)
+	}
+
+	return out
+}
+
+func TestAllocate(t *testing.T) {
+	tests := []struct {
+		name      string
+		allocator ethunits.Allocator
+		total     int64
+		weights   []*big.Int
+		want      []*big.Int
+		wantErr   error
+	}{
+		{name: "exact", total: 100, weights: bigInts(1, 3), want: bigInts(25, 75)},
+		{name: "largest remainder", total: 100, weights: bigInts(1, 1, 1), want: bigInts(34, 33, 33)},
+		{name: "remainder order", total: 10, weights: bigInts(1, 2, 4), want: bigInts(1, 3, 6)},
+		{name: "zero weight", total: 10, weights: bigInts(0, 1, 1), want: bigInts(0, 5, 5)},
+		{name: "zero total", total: 0, weights: bigInts(1, 2), want: bigInts(0, 0)},
+		{
+			name:      "dust threshold",
+			allocator: ethunits.Allocator{MinShare: big.NewInt(10)},
+			total:     100,
+			weights:   bigInts(1, 1, 50, 48),
+			want:      bigInts(0, 0, 51, 49),
+		},
+		{
+			name:      "dust threshold keeps last part",
+			allocator: ethunits.Allocator{MinShare: big.NewInt(1000)},
+			total:     100,
+			weights:   bigInts(1, 2),
+			want:      bigInts(0, 100),
+		},
+		{name: "negative weight", total: 10, weights: bigInts(-1, 2), wantErr: ethunits.ErrInvalidWeights},
+		{name: "no weights", total: 10, weights: bigInts(0, 0), wantErr: ethunits.ErrInvalidWeights},
+		{name: "negative total", total: -10, weights: bigInts(1), wantErr: ethunits.ErrNegativeAmount},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.allocator.Allocate(big.NewInt(tt.total), tt.weights...)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAllocate_SumsToTotal(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	total := makeBigInt(wantWeiStr)
+
+	for i := 0; i < 100; i++ {
+		weights := make([]*big.Int, 1+rng.Intn(50))
+		for j := range weights {
+			weights[j] = big.NewInt(rng.Int63n(1_000_000))
+		}
+		weights[0].Add(weights[0], big.NewInt(1))
+
+		parts, err := ethunits.Allocator{MinShare: makeBigInt("1000000000000000000")}.Allocate(total, weights...)
+		require.NoError(t, err)
+
+		sum := new(big.Int)
+		for _, p := range parts {
+			sum.Add(sum, p)
+		}
+		assertBigIntEqual(t, total, sum)
+	}
+}
+
+func TestSplit(t *testing.T) {
+	got, err := ethunits.Split(big.NewInt(11), 4)
+	require.NoError(t, err)
+	assert.Equal(t, bigInts(3, 3, 3, 2), got)
+
+	got, err = ethunits.Split(makeBigInt(wantWeiStr), 3)
+	require.NoError(t, err)
+	assert.Equal(t, []*big.Int{
+		makeBigInt("114166666666666666667"),
+		makeBigInt("114166666666666666667"),
+		makeBigInt("114166666666666666666"),
+	}, got)
+
+	_, err = ethunits.Split(big.NewInt(11), 0)
+	assert.ErrorIs(t, err, ethunits.ErrInvalidParts)
+}
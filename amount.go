@@ -0,0 +1,130 @@
+package ethunits
+
+import (
+	"flag"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Amount is an amount of Wei together with the Unit it is displayed in,
+// and in which amounts without a unit suffix are parsed.
+// The zero value is zero Wei, displayed in Wei.
+//
+// *Amount implements flag.Value, and the Type method used by
+// github.com/spf13/pflag, so amounts such as "30gwei" or "0.1 ether"
+// can be taken as command-line flags.
+type Amount struct {
+	Wei  *big.Int
+	Unit Unit
+}
+
+// NewAmount returns an Amount of wei displayed in u.
+func NewAmount(wei *big.Int, u Unit) *Amount {
+	return &Amount{Wei: wei, Unit: u}
+}
+
+// ParseAmountIn parses an amount with ParseAmount, returning an Amount
+// displayed in defaultUnit.
+func ParseAmountIn(s string, defaultUnit Unit) (*Amount, error) {
+	a := &Amount{Unit: defaultUnit}
+	if err := a.Set(s); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// String renders the amount in its unit, e.g. "30 gwei".
+func (a *Amount) String() string {
+	if a == nil {
+		return ""
+	}
+
+	wei := a.Wei
+	if wei == nil {
+		wei = new(big.Int)
+	}
+
+	s, ok := FormatWei(wei, a.Unit)
+	if !ok {
+		return wei.String() + " wei"
+	}
+
+	return s + " " + unitName(a.Unit)
+}
+
+// Set parses s like ParseAmount, reading amounts without a unit suffix in
+// a.Unit. The unit of a is left unchanged.
+func (a *Amount) Set(s string) error {
+	wei, _, err := parseAmount(s, a.Unit)
+	if err != nil {
+		return err
+	}
+
+	a.Wei = wei
+
+	return nil
+}
+
+// Type returns the name of the flag's value type, for pflag.
+func (a *Amount) Type() string {
+	return "amount"
+}
+
+// Set sets u to the unit with the given name, as accepted by ParseUnit.
+func (u *Unit) Set(s string) error {
+	parsed, ok := ParseUnit(s)
+	if !ok {
+		return fmt.Errorf("%w %q: want one of %s", ErrUnknownUnit, s, strings.Join(unitNames(), ", "))
+	}
+
+	*u = parsed
+
+	return nil
+}
+
+// Type returns the name of the flag's value type, for pflag.
+func (u *Unit) Type() string {
+	return "unit"
+}
+
+// AmountVar defines an amount flag with the given name, default value and
+// usage string. Amounts given without a unit suffix are read in the unit of
+// value, or in Ether if value has no unit suffix; that unit is also the unit
+// the flag's value is displayed in. AmountVar panics if value can't be parsed.
+func AmountVar(fs *flag.FlagSet, name, value, usage string) *Amount {
+	wei, u, err := parseAmount(value, Ether)
+	if err != nil {
+		panic("ethunits: invalid default for flag -" + name + ": " + err.Error())
+	}
+
+	a := NewAmount(wei, u)
+	fs.Var(a, name, usage)
+
+	return a
+}
+
+// UnitVar defines a unit flag with the given name, default value and usage string.
+func UnitVar(fs *flag.FlagSet, name string, value Unit, usage string) *Unit {
+	u := new(Unit)
+	*u = value
+	fs.Var(u, name, usage)
+
+	return u
+}
+
+func unitName(u Unit) string {
+	return strings.ToLower(u.String())
+}
+
+func unitNames() []string {
+	units := Units()
+
+	names := make([]string, len(units))
+	for i, u := range units {
+		names[i] = unitName(u)
+	}
+
+	return names
+}
@@ -0,0 +1,86 @@
+package ethunits_test
+
+import (
+	"bytes"
+	"flag"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jalavosus/go-ethunits"
+)
+
+func TestAmountSet(t *testing.T) {
+	tests := []struct {
+		name    string
+		unit    ethunits.Unit
+		input   string
+		want    string
+		wantErr string
+	}{
+		{name: "suffix", unit: ethunits.GWei, input: "0.1ether", want: "100000000 gwei"},
+		{name: "default unit", unit: ethunits.GWei, input: "30", want: "30 gwei"},
+		{name: "spaced suffix", unit: ethunits.Ether, input: "342.5 Ether", want: wantEtherStr + " ether"},
+		{name: "hex", unit: ethunits.Ether, input: "0x4a817c800", want: "0.00000002 ether"},
+		{name: "unknown unit", unit: ethunits.Ether, input: "1 dogecoin", wantErr: `unknown unit "dogecoin" in "1 dogecoin"`},
+		{name: "not a number", unit: ethunits.Ether, input: "lots", wantErr: `invalid amount "lots": want a number with an optional unit, such as "30gwei" or "0.1 ether"`},
+		{name: "fractional wei", unit: ethunits.GWei, input: "0.0000000001", wantErr: `invalid amount "0.0000000001": not a whole number of wei`},
+		{name: "hex in gwei", unit: ethunits.Ether, input: "0x10 gwei", wantErr: `invalid amount "0x10 gwei": hexadecimal amounts must be in wei`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := ethunits.NewAmount(nil, tt.unit)
+
+			err := a.Set(tt.input)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, a.String())
+			assert.Equal(t, tt.unit, a.Unit)
+		})
+	}
+
+	_, err := ethunits.ParseAmountIn("1 dogecoin", ethunits.Ether)
+	assert.ErrorIs(t, err, ethunits.ErrUnknownUnit)
+
+	_, err = ethunits.ParseAmountIn("lots", ethunits.Ether)
+	assert.ErrorIs(t, err, ethunits.ErrInvalidAmount)
+
+	assert.Equal(t, "0 wei", new(ethunits.Amount).String())
+	assert.Equal(t, "amount", new(ethunits.Amount).Type())
+}
+
+func TestAmountVar(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+
+	var usage bytes.Buffer
+	fs.SetOutput(&usage)
+
+	maxFee := ethunits.AmountVar(fs, "max-fee", "30gwei", "maximum fee per gas")
+	value := ethunits.AmountVar(fs, "value", "0", "amount to send")
+	unit := ethunits.UnitVar(fs, "unit", ethunits.Ether, "display unit")
+
+	assert.Equal(t, "30 gwei", maxFee.String())
+	assert.Equal(t, "0 ether", value.String())
+
+	require.NoError(t, fs.Parse([]string{"-max-fee", "45", "-value", "0.1", "-unit", "shannon"}))
+	assert.Equal(t, big.NewInt(45000000000), maxFee.Wei)
+	assert.Equal(t, makeBigInt("100000000000000000"), value.Wei)
+	assert.Equal(t, ethunits.GWei, *unit)
+
+	err := fs.Parse([]string{"-unit", "satoshi"})
+	assert.EqualError(t, err, `invalid value "satoshi" for flag -unit: unknown unit "satoshi": want one of wei, kwei, mwei, gwei, szabo, finney, ether`)
+
+	fs.PrintDefaults()
+	assert.Contains(t, usage.String(), "maximum fee per gas (default 30 gwei)")
+
+	assert.Panics(t, func() {
+		ethunits.AmountVar(fs, "bad", "1 dogecoin", "")
+	})
+}
@@ -0,0 +1,128 @@
+// Package beacon implements consensus-layer balance accounting,
+// where balances are uint64 amounts of Gwei rather than Wei.
+package beacon
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"strconv"
+
+	"github.com/jalavosus/go-ethunits"
+)
+
+var (
+	ErrOverflow       = errors.New("beacon: gwei overflow")
+	ErrUnderflow      = errors.New("beacon: gwei underflow")
+	ErrDivisionByZero = errors.New("beacon: division by zero")
+	ErrNotWholeGwei   = errors.New("beacon: amount is not a whole number of gwei")
+	ErrInvalidAmount  = errors.New("beacon: invalid amount")
+)
+
+// Gwei is an amount of the consensus layer's currency, as stored in
+// beacon state balances.
+type Gwei uint64
+
+// GweiPerEther is the number of Gwei in one Ether.
+const GweiPerEther Gwei = 1_000_000_000
+
+var weiPerGwei = big.NewInt(1_000_000_000)
+
+// FromWei converts an execution-layer amount of Wei into Gwei.
+// It returns ErrNotWholeGwei if the amount has a fractional Gwei part,
+// and ErrOverflow if it doesn't fit in a uint64.
+func FromWei(wei *big.Int) (Gwei, error) {
+	if wei.Sign() < 0 {
+		return 0, ErrUnderflow
+	}
+
+	q, r := new(big.Int).QuoRem(wei, weiPerGwei, new(big.Int))
+	if r.Sign() != 0 {
+		return 0, ErrNotWholeGwei
+	}
+
+	if !q.IsUint64() {
+		return 0, ErrOverflow
+	}
+
+	return Gwei(q.Uint64()), nil
+}
+
+// FromEther parses a decimal amount of Ether, such as "32" or "0.25", into Gwei.
+// It returns ErrInvalidAmount if ether isn't a decimal number with at most
+// 18 decimal places, and otherwise fails like FromWei.
+func FromEther(ether string) (Gwei, error) {
+	wei, ok := ethunits.ParseUnits(ether, 18)
+	if !ok {
+		return 0, ErrInvalidAmount
+	}
+
+	return FromWei(wei)
+}
+
+// Wei converts g into an execution-layer amount of Wei.
+func (g Gwei) Wei() *big.Int {
+	wei := new(big.Int).SetUint64(uint64(g))
+	return wei.Mul(wei, weiPerGwei)
+}
+
+// Ether returns g as an exact decimal amount of Ether, e.g. "32.25".
+func (g Gwei) Ether() string {
+	return ethunits.FormatUnits(new(big.Int).SetUint64(uint64(g)), 9)
+}
+
+func (g Gwei) String() string {
+	return strconv.FormatUint(uint64(g), 10)
+}
+
+// Add returns g + x.
+func (g Gwei) Add(x Gwei) (Gwei, error) {
+	if g > math.MaxUint64-x {
+		return 0, ErrOverflow
+	}
+
+	return g + x, nil
+}
+
+// Sub returns g - x.
+func (g Gwei) Sub(x Gwei) (Gwei, error) {
+	if x > g {
+		return 0, ErrUnderflow
+	}
+
+	return g - x, nil
+}
+
+// Mul returns g * n.
+func (g Gwei) Mul(n uint64) (Gwei, error) {
+	if n != 0 && uint64(g) > math.MaxUint64/n {
+		return 0, ErrOverflow
+	}
+
+	return g * Gwei(n), nil
+}
+
+// Div returns g / n, rounded down.
+func (g Gwei) Div(n uint64) (Gwei, error) {
+	if n == 0 {
+		return 0, ErrDivisionByZero
+	}
+
+	return g / Gwei(n), nil
+}
+
+// Sum returns the sum of amounts.
+func Sum(amounts ...Gwei) (Gwei, error) {
+	var (
+		total Gwei
+		err   error
+	)
+
+	for _, a := range amounts {
+		if total, err = total.Add(a); err != nil {
+			return 0, err
+		}
+	}
+
+	return total, nil
+}
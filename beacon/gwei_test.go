@@ -0,0 +1,77 @@
+package beacon_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jalavosus/go-ethunits"
+	"github.com/jalavosus/go-ethunits/beacon"
+)
+
+func TestGwei_Arithmetic(t *testing.T) {
+	sum, err := beacon.Gwei(1).Add(2)
+	require.NoError(t, err)
+	assert.Equal(t, beacon.Gwei(3), sum)
+
+	_, err = beacon.Gwei(math.MaxUint64).Add(1)
+	assert.ErrorIs(t, err, beacon.ErrOverflow)
+
+	_, err = beacon.Gwei(1).Sub(2)
+	assert.ErrorIs(t, err, beacon.ErrUnderflow)
+
+	_, err = beacon.Gwei(math.MaxUint64 / 2).Mul(3)
+	assert.ErrorIs(t, err, beacon.ErrOverflow)
+
+	product, err := beacon.Gwei(0).Mul(math.MaxUint64)
+	require.NoError(t, err)
+	assert.Equal(t, beacon.Gwei(0), product)
+
+	_, err = beacon.Gwei(1).Div(0)
+	assert.ErrorIs(t, err, beacon.ErrDivisionByZero)
+
+	total, err := beacon.Sum(beacon.MinActivationBalance, beacon.MinActivationBalance)
+	require.NoError(t, err)
+	assert.Equal(t, "64", total.Ether())
+
+	_, err = beacon.Sum(math.MaxUint64, 1)
+	assert.ErrorIs(t, err, beacon.ErrOverflow)
+}
+
+func TestGwei_Wei(t *testing.T) {
+	wei, ok := ethunits.ToWei("32.25", ethunits.Ether)
+	require.True(t, ok)
+
+	g, err := beacon.FromWei(wei)
+	require.NoError(t, err)
+	assert.Equal(t, beacon.Gwei(32_250_000_000), g)
+	assert.Equal(t, "32.25", g.Ether())
+	assert.Equal(t, wei, g.Wei())
+
+	fromEther, err := beacon.FromEther("32.25")
+	require.NoError(t, err)
+	assert.Equal(t, g, fromEther)
+
+	_, err = beacon.FromWei(big.NewInt(1))
+	assert.ErrorIs(t, err, beacon.ErrNotWholeGwei)
+
+	_, err = beacon.FromEther("0.0000000001")
+	assert.ErrorIs(t, err, beacon.ErrNotWholeGwei)
+
+	for _, s := range []string{"", "abc", "1.2.3", "32 ether"} {
+		_, err = beacon.FromEther(s)
+		assert.ErrorIsf(t, err, beacon.ErrInvalidAmount, "FromEther(%q)", s)
+	}
+
+	_, err = beacon.FromWei(new(big.Int).Lsh(big.NewInt(1_000_000_000), 64))
+	assert.ErrorIs(t, err, beacon.ErrOverflow)
+
+	_, err = beacon.FromWei(big.NewInt(-1_000_000_000))
+	assert.ErrorIs(t, err, beacon.ErrUnderflow)
+
+	max := beacon.Gwei(math.MaxUint64)
+	assert.Equal(t, "18446744073709551615000000000", max.Wei().String())
+}
@@ -0,0 +1,227 @@
+package beacon
+
+import (
+	"errors"
+	"math/big"
+	"time"
+)
+
+var (
+	ErrZeroPrincipal = errors.New("beacon: principal must be positive")
+	ErrZeroDuration  = errors.New("beacon: duration must be positive")
+	ErrInvalidRate   = errors.New("beacon: rate must be greater than -100%")
+)
+
+// Consensus-layer timing parameters.
+const (
+	SecondsPerSlot = 12
+	SlotsPerEpoch  = 32
+	SecondsPerYear = 365 * 24 * 60 * 60
+)
+
+// Amount is an amount of consensus or execution layer currency.
+type Amount interface{ *big.Int | Gwei }
+
+// Compounding is the number of times per year rewards are compounded.
+type Compounding uint64
+
+const (
+	// NoCompounding accrues simple interest.
+	NoCompounding   Compounding = 0
+	CompoundYearly  Compounding = 1
+	CompoundMonthly Compounding = 12
+	CompoundDaily   Compounding = 365
+	// CompoundPerEpoch compounds once every epoch, 82125 times a year.
+	CompoundPerEpoch Compounding = SecondsPerYear / (SecondsPerSlot * SlotsPerEpoch)
+)
+
+// ratePrecision is the number of decimal digits kept by the
+// fixed-point arithmetic used for compounding.
+const ratePrecision = 60
+
+var rateScale = new(big.Int).Exp(big.NewInt(10), big.NewInt(ratePrecision), nil)
+
+func toBigInt[T Amount](amount T) *big.Int {
+	switch x := (any)(amount).(type) {
+	case *big.Int:
+		return x
+	case Gwei:
+		return new(big.Int).SetUint64(uint64(x))
+	}
+
+	return nil
+}
+
+// APR returns the annual percentage rate, as a fraction (0.035 for 3.5%),
+// of earning reward on principal over the elapsed duration.
+// The result is exact; reward and principal must share a denomination.
+func APR[T Amount](reward, principal T, elapsed time.Duration) (*big.Rat, error) {
+	return aprOver(toBigInt(reward), toBigInt(principal), big.NewInt(int64(elapsed)), big.NewInt(int64(SecondsPerYear*time.Second)))
+}
+
+// APRFromSlots is like APR, with the elapsed duration given in slots.
+func APRFromSlots[T Amount](reward, principal T, slots uint64) (*big.Rat, error) {
+	return aprOver(toBigInt(reward), toBigInt(principal), new(big.Int).SetUint64(slots), big.NewInt(SecondsPerYear/SecondsPerSlot))
+}
+
+// APRFromEpochs is like APR, with the elapsed duration given in epochs.
+func APRFromEpochs[T Amount](reward, principal T, epochs uint64) (*big.Rat, error) {
+	return aprOver(toBigInt(reward), toBigInt(principal), new(big.Int).SetUint64(epochs), big.NewInt(int64(CompoundPerEpoch)))
+}
+
+// aprOver returns reward / principal * perYear / elapsed.
+func aprOver(reward, principal, elapsed, perYear *big.Int) (*big.Rat, error) {
+	if principal.Sign() <= 0 {
+		return nil, ErrZeroPrincipal
+	}
+
+	if elapsed.Sign() <= 0 {
+		return nil, ErrZeroDuration
+	}
+
+	apr := new(big.Rat).SetFrac(new(big.Int).Mul(reward, perYear), new(big.Int).Mul(principal, elapsed))
+
+	return apr, nil
+}
+
+// APRToAPY converts an annual percentage rate into the annual percentage yield
+// earned when compounding c times a year, (1 + apr/c)^c - 1.
+// The result is accurate to well beyond 40 decimal places.
+func APRToAPY(apr *big.Rat, c Compounding) (*big.Rat, error) {
+	if c == NoCompounding {
+		return new(big.Rat).Set(apr), nil
+	}
+
+	periodRate := new(big.Rat).Quo(apr, new(big.Rat).SetUint64(uint64(c)))
+	growth, err := compound(periodRate, new(big.Int).SetUint64(uint64(c)))
+	if err != nil {
+		return nil, err
+	}
+
+	return growth.Sub(growth, big.NewRat(1, 1)), nil
+}
+
+// APYToAPR converts an annual percentage yield earned when compounding c times
+// a year back into an annual percentage rate, c * ((1 + apy)^(1/c) - 1).
+// The result is accurate to well beyond 40 decimal places.
+func APYToAPR(apy *big.Rat, c Compounding) (*big.Rat, error) {
+	if c == NoCompounding {
+		return new(big.Rat).Set(apy), nil
+	}
+
+	growth := toFixed(new(big.Rat).Add(apy, big.NewRat(1, 1)))
+	if growth.Sign() <= 0 {
+		return nil, ErrInvalidRate
+	}
+
+	root := fixedRoot(growth, new(big.Int).SetUint64(uint64(c)))
+	root.Sub(root, rateScale)
+	root.Mul(root, new(big.Int).SetUint64(uint64(c)))
+
+	return new(big.Rat).SetFrac(root, rateScale), nil
+}
+
+// ProjectedReward returns the reward earned on principal at the given annual
+// percentage rate over the elapsed duration, compounding c times a year.
+// Whole compounding periods are compounded, and simple interest accrues over
+// the final partial period. The reward is rounded down, towards negative
+// infinity for negative rates, in the denomination of principal.
+// It returns ErrInvalidRate if apr is -100% or less, since no more than the
+// principal can be lost.
+func ProjectedReward[T Amount](principal T, apr *big.Rat, elapsed time.Duration, c Compounding) (*big.Int, error) {
+	if elapsed <= 0 {
+		return nil, ErrZeroDuration
+	}
+
+	if apr.Cmp(big.NewRat(-1, 1)) <= 0 {
+		return nil, ErrInvalidRate
+	}
+
+	p := toBigInt(principal)
+	year := new(big.Int).SetInt64(int64(SecondsPerYear * time.Second))
+	t := big.NewInt(int64(elapsed))
+
+	growth := big.NewRat(1, 1)
+	if c != NoCompounding {
+		periods, rest := new(big.Int).QuoRem(new(big.Int).Mul(t, new(big.Int).SetUint64(uint64(c))), year, new(big.Int))
+
+		var err error
+		growth, err = compound(new(big.Rat).Quo(apr, new(big.Rat).SetUint64(uint64(c))), periods)
+		if err != nil {
+			return nil, err
+		}
+
+		// The remaining fraction of a period, in years.
+		t = rest
+		year.Mul(year, new(big.Int).SetUint64(uint64(c)))
+	}
+
+	simple := new(big.Rat).Mul(apr, new(big.Rat).SetFrac(t, year))
+	simple.Add(simple, big.NewRat(1, 1))
+	growth.Mul(growth, simple)
+
+	total := new(big.Rat).Mul(new(big.Rat).SetInt(p), growth)
+	reward := new(big.Int).Div(total.Num(), total.Denom())
+
+	return reward.Sub(reward, p), nil
+}
+
+// compound returns (1 + rate)^periods.
+func compound(rate *big.Rat, periods *big.Int) (*big.Rat, error) {
+	growth := toFixed(new(big.Rat).Add(rate, big.NewRat(1, 1)))
+	if growth.Sign() <= 0 {
+		return nil, ErrInvalidRate
+	}
+
+	return new(big.Rat).SetFrac(fixedPow(growth, periods), rateScale), nil
+}
+
+func toFixed(r *big.Rat) *big.Int {
+	v := new(big.Int).Mul(r.Num(), rateScale)
+	return v.Quo(v, r.Denom())
+}
+
+func fixedMul(a, b *big.Int) *big.Int {
+	v := new(big.Int).Mul(a, b)
+	return v.Quo(v, rateScale)
+}
+
+// fixedPow returns x^n by repeated squaring.
+func fixedPow(x, n *big.Int) *big.Int {
+	result := new(big.Int).Set(rateScale)
+	base := new(big.Int).Set(x)
+
+	for i := 0; i < n.BitLen(); i++ {
+		if n.Bit(i) == 1 {
+			result = fixedMul(result, base)
+		}
+		base = fixedMul(base, base)
+	}
+
+	return result
+}
+
+// fixedRoot returns x^(1/n) for x close to 1, using Newton's method.
+func fixedRoot(x, n *big.Int) *big.Int {
+	nMinusOne := new(big.Int).Sub(n, big.NewInt(1))
+
+	// 1 + (x - 1) / n is within a few iterations of the root.
+	y := new(big.Int).Sub(x, rateScale)
+	y.Quo(y, n)
+	y.Add(y, rateScale)
+
+	for i := 0; i < 100; i++ {
+		// y' = ((n - 1) * y + x / y^(n-1)) / n
+		pow := fixedPow(y, nMinusOne)
+		next := new(big.Int).Mul(nMinusOne, y)
+		next.Add(next, new(big.Int).Quo(new(big.Int).Mul(x, rateScale), pow))
+		next.Quo(next, n)
+
+		if new(big.Int).Sub(next, y).CmpAbs(big.NewInt(1)) <= 0 {
+			return next
+		}
+		y = next
+	}
+
+	return y
+}
@@ -0,0 +1,140 @@
+package beacon_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jalavosus/go-ethunits"
+	"github.com/jalavosus/go-ethunits/beacon"
+)
+
+const day = 24 * time.Hour
+
+func TestAPR(t *testing.T) {
+	principal := ether(t, "32")
+
+	apr, err := beacon.APR(ether(t, "1.12"), principal, 365*day)
+	require.NoError(t, err)
+	assert.Equal(t, "7/200", apr.RatString())
+
+	aprWei, err := beacon.APR(ether(t, "1.12").Wei(), principal.Wei(), 365*day)
+	require.NoError(t, err)
+	assert.Equal(t, apr, aprWei)
+
+	reward := ether(t, "0.003068")
+	fromDuration, err := beacon.APR(reward, principal, 225*beacon.SlotsPerEpoch*beacon.SecondsPerSlot*time.Second)
+	require.NoError(t, err)
+
+	fromSlots, err := beacon.APRFromSlots(reward, principal, 225*beacon.SlotsPerEpoch)
+	require.NoError(t, err)
+
+	fromEpochs, err := beacon.APRFromEpochs(reward, principal, 225)
+	require.NoError(t, err)
+
+	assert.Equal(t, fromDuration, fromSlots)
+	assert.Equal(t, fromDuration, fromEpochs)
+	assert.Equal(t, "55991/1600000", fromEpochs.RatString())
+
+	penalty, err := beacon.APR(big.NewInt(-1), big.NewInt(100), 365*day)
+	require.NoError(t, err)
+	assert.Equal(t, "-1/100", penalty.RatString())
+
+	_, err = beacon.APR(reward, 0, day)
+	assert.ErrorIs(t, err, beacon.ErrZeroPrincipal)
+
+	_, err = beacon.APRFromEpochs(reward, principal, 0)
+	assert.ErrorIs(t, err, beacon.ErrZeroDuration)
+}
+
+func TestAPRToAPY(t *testing.T) {
+	tests := []struct {
+		name        string
+		compounding beacon.Compounding
+		want        string
+	}{
+		{name: "none", compounding: beacon.NoCompounding, want: "0.050000000000000000000000000000000000000000"},
+		{name: "yearly", compounding: beacon.CompoundYearly, want: "0.050000000000000000000000000000000000000000"},
+		{name: "monthly", compounding: beacon.CompoundMonthly, want: "0.051161897881733189804873890960800098526889"},
+		{name: "daily", compounding: beacon.CompoundDaily, want: "0.051267496467462550454968149773795461021531"},
+	}
+
+	apr := big.NewRat(5, 100)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apy, err := beacon.APRToAPY(apr, tt.compounding)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, apy.FloatString(42))
+
+			back, err := beacon.APYToAPR(apy, tt.compounding)
+			require.NoError(t, err)
+			assert.Equal(t, apr.FloatString(40), back.FloatString(40))
+		})
+	}
+
+	apy, err := beacon.APRToAPY(apr, beacon.CompoundPerEpoch)
+	require.NoError(t, err)
+	assert.Equal(t, "0.051271080374948519451540446295986620854787", apy.FloatString(42))
+
+	back, err := beacon.APYToAPR(apy, beacon.CompoundPerEpoch)
+	require.NoError(t, err)
+	assert.Equal(t, apr.FloatString(40), back.FloatString(40))
+
+	_, err = beacon.APRToAPY(big.NewRat(-13, 1), beacon.CompoundMonthly)
+	assert.ErrorIs(t, err, beacon.ErrInvalidRate)
+}
+
+func TestProjectedReward(t *testing.T) {
+	principal := ether(t, "32").Wei()
+	apr := big.NewRat(5, 100)
+
+	tests := []struct {
+		name        string
+		elapsed     time.Duration
+		compounding beacon.Compounding
+		want        string
+	}{
+		{name: "simple", elapsed: 365 * day, compounding: beacon.NoCompounding, want: "1.6"},
+		{name: "yearly", elapsed: 365 * day, compounding: beacon.CompoundYearly, want: "1.6"},
+		{name: "monthly", elapsed: 365 * day, compounding: beacon.CompoundMonthly, want: "1.637180732215462073"},
+		{name: "daily", elapsed: 45 * day, compounding: beacon.CompoundDaily, want: "0.197855925920623235"},
+		{name: "partial period", elapsed: 45*day + 12*time.Hour, compounding: beacon.CompoundDaily, want: "0.200061258518289031"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reward, err := beacon.ProjectedReward(principal, apr, tt.elapsed, tt.compounding)
+			require.NoError(t, err)
+
+			got, ok := ethunits.FormatWei(reward, ethunits.Ether)
+			require.True(t, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	reward, err := beacon.ProjectedReward(ether(t, "32"), apr, 365*day, beacon.NoCompounding)
+	require.NoError(t, err)
+	assert.Equal(t, "1.6", ethunits.FormatUnits(reward, 9))
+
+	// Losses are rounded down too, away from zero.
+	reward, err = beacon.ProjectedReward(big.NewInt(3), big.NewRat(-1, 2), 365*day, beacon.NoCompounding)
+	require.NoError(t, err)
+	assert.Equal(t, "-2", reward.String())
+
+	for _, c := range []beacon.Compounding{beacon.NoCompounding, beacon.CompoundDaily} {
+		_, err = beacon.ProjectedReward(principal, big.NewRat(-1, 1), 365*day, c)
+		assert.ErrorIs(t, err, beacon.ErrInvalidRate)
+
+		_, err = beacon.ProjectedReward(principal, big.NewRat(-3, 2), 365*day, c)
+		assert.ErrorIs(t, err, beacon.ErrInvalidRate)
+	}
+
+	_, err = beacon.ProjectedReward(principal, apr, 0, beacon.NoCompounding)
+	assert.ErrorIs(t, err, beacon.ErrZeroDuration)
+
+	_, err = beacon.ProjectedReward(principal, apr, -day, beacon.CompoundDaily)
+	assert.ErrorIs(t, err, beacon.ErrZeroDuration)
+}
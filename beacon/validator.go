@@ -0,0 +1,123 @@
+package beacon
+
+// Epoch is a consensus-layer epoch number.
+type Epoch uint64
+
+// FarFutureEpoch is the withdrawable epoch of a validator which hasn't exited.
+const FarFutureEpoch Epoch = 1<<64 - 1
+
+// Consensus-layer balance parameters, as defined by the Electra specification.
+const (
+	EffectiveBalanceIncrement    Gwei = 1 * GweiPerEther
+	MinActivationBalance         Gwei = 32 * GweiPerEther
+	MaxEffectiveBalanceElectra   Gwei = 2048 * GweiPerEther
+	HysteresisQuotient                = 4
+	HysteresisDownwardMultiplier      = 1
+	HysteresisUpwardMultiplier        = 5
+)
+
+const (
+	hysteresisIncrement = EffectiveBalanceIncrement / HysteresisQuotient
+	downwardThreshold   = hysteresisIncrement * HysteresisDownwardMultiplier
+	upwardThreshold     = hysteresisIncrement * HysteresisUpwardMultiplier
+)
+
+// WithdrawalPrefix is the first byte of a validator's withdrawal credentials.
+type WithdrawalPrefix byte
+
+const (
+	// BLSWithdrawalPrefix marks credentials which can't receive withdrawals.
+	BLSWithdrawalPrefix WithdrawalPrefix = 0x00
+	// ETH1AddressWithdrawalPrefix marks credentials which withdraw to an
+	// execution-layer address, with a 32 ETH maximum effective balance.
+	ETH1AddressWithdrawalPrefix WithdrawalPrefix = 0x01
+	// CompoundingWithdrawalPrefix marks credentials which withdraw to an
+	// execution-layer address, with a 2048 ETH maximum effective balance.
+	CompoundingWithdrawalPrefix WithdrawalPrefix = 0x02
+)
+
+// HasExecutionWithdrawalCredential reports whether p withdraws to an
+// execution-layer address.
+func (p WithdrawalPrefix) HasExecutionWithdrawalCredential() bool {
+	return p == ETH1AddressWithdrawalPrefix || p == CompoundingWithdrawalPrefix
+}
+
+// WithdrawalKind classifies the withdrawal the sweep makes for a validator.
+type WithdrawalKind uint8
+
+const (
+	// NoWithdrawal means the sweep skips the validator.
+	NoWithdrawal WithdrawalKind = iota
+	// PartialWithdrawal means the sweep withdraws the balance in excess of
+	// the validator's maximum effective balance.
+	PartialWithdrawal
+	// FullWithdrawal means the sweep withdraws the validator's whole balance.
+	FullWithdrawal
+)
+
+func (k WithdrawalKind) String() string {
+	switch k {
+	case PartialWithdrawal:
+		return "partial"
+	case FullWithdrawal:
+		return "full"
+	default:
+		return "none"
+	}
+}
+
+// Validator holds the fields of a beacon state validator which
+// balance accounting depends on.
+type Validator struct {
+	WithdrawalPrefix  WithdrawalPrefix
+	EffectiveBalance  Gwei
+	WithdrawableEpoch Epoch
+}
+
+// MaxEffectiveBalance returns the cap on the validator's effective balance,
+// like get_max_effective_balance.
+func (v Validator) MaxEffectiveBalance() Gwei {
+	if v.WithdrawalPrefix == CompoundingWithdrawalPrefix {
+		return MaxEffectiveBalanceElectra
+	}
+
+	return MinActivationBalance
+}
+
+// NextEffectiveBalance returns the validator's effective balance after an
+// epoch transition with the given actual balance, applying the hysteresis
+// rules of process_effective_balance_updates.
+func (v Validator) NextEffectiveBalance(balance Gwei) Gwei {
+	eb := v.EffectiveBalance
+
+	// balance + downwardThreshold < eb || eb + upwardThreshold < balance,
+	// rearranged so neither side can overflow.
+	if (eb > downwardThreshold && balance < eb-downwardThreshold) || (balance > upwardThreshold && eb < balance-upwardThreshold) {
+		eb = balance - balance%EffectiveBalanceIncrement
+		if maxEB := v.MaxEffectiveBalance(); eb > maxEB {
+			eb = maxEB
+		}
+	}
+
+	return eb
+}
+
+// Withdrawal classifies the withdrawal the sweep makes for the validator with
+// the given balance at the given epoch, and returns its amount, like
+// get_expected_withdrawals.
+func (v Validator) Withdrawal(balance Gwei, epoch Epoch) (WithdrawalKind, Gwei) {
+	if !v.WithdrawalPrefix.HasExecutionWithdrawalCredential() {
+		return NoWithdrawal, 0
+	}
+
+	if v.WithdrawableEpoch <= epoch && balance > 0 {
+		return FullWithdrawal, balance
+	}
+
+	maxEB := v.MaxEffectiveBalance()
+	if v.EffectiveBalance == maxEB && balance > maxEB {
+		return PartialWithdrawal, balance - maxEB
+	}
+
+	return NoWithdrawal, 0
+}
@@ -0,0 +1,120 @@
+package beacon_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jalavosus/go-ethunits/beacon"
+)
+
+func ether(t *testing.T, s string) beacon.Gwei {
+	t.Helper()
+	g, err := beacon.FromEther(s)
+	require.NoError(t, err)
+	return g
+}
+
+func TestValidator_NextEffectiveBalance(t *testing.T) {
+	tests := []struct {
+		name      string
+		prefix    beacon.WithdrawalPrefix
+		effective string
+		balance   string
+		want      string
+	}{
+		{name: "unchanged", prefix: beacon.ETH1AddressWithdrawalPrefix, effective: "32", balance: "32.1", want: "32"},
+		{name: "small drop within hysteresis", prefix: beacon.ETH1AddressWithdrawalPrefix, effective: "32", balance: "31.75", want: "32"},
+		{name: "drop past downward threshold", prefix: beacon.ETH1AddressWithdrawalPrefix, effective: "32", balance: "31.74", want: "31"},
+		{name: "rise within hysteresis", prefix: beacon.ETH1AddressWithdrawalPrefix, effective: "31", balance: "32.25", want: "31"},
+		{name: "rise past upward threshold", prefix: beacon.ETH1AddressWithdrawalPrefix, effective: "31", balance: "32.26", want: "32"},
+		{name: "capped at 32 ETH", prefix: beacon.ETH1AddressWithdrawalPrefix, effective: "31", balance: "40", want: "32"},
+		{name: "compounding", prefix: beacon.CompoundingWithdrawalPrefix, effective: "32", balance: "40.5", want: "40"},
+		{name: "compounding capped at 2048 ETH", prefix: beacon.CompoundingWithdrawalPrefix, effective: "2000", balance: "2100", want: "2048"},
+		{name: "zero", prefix: beacon.BLSWithdrawalPrefix, effective: "0", balance: "0", want: "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := beacon.Validator{WithdrawalPrefix: tt.prefix, EffectiveBalance: ether(t, tt.effective)}
+			assert.Equal(t, tt.want, v.NextEffectiveBalance(ether(t, tt.balance)).Ether())
+		})
+	}
+}
+
+func TestValidator_Withdrawal(t *testing.T) {
+	tests := []struct {
+		name       string
+		validator  beacon.Validator
+		balance    string
+		epoch      beacon.Epoch
+		wantKind   beacon.WithdrawalKind
+		wantAmount string
+	}{
+		{
+			name:       "partial",
+			validator:  beacon.Validator{WithdrawalPrefix: beacon.ETH1AddressWithdrawalPrefix, EffectiveBalance: ether(t, "32"), WithdrawableEpoch: beacon.FarFutureEpoch},
+			balance:    "32.0123",
+			epoch:      100,
+			wantKind:   beacon.PartialWithdrawal,
+			wantAmount: "0.0123",
+		},
+		{
+			name:       "below max effective balance",
+			validator:  beacon.Validator{WithdrawalPrefix: beacon.ETH1AddressWithdrawalPrefix, EffectiveBalance: ether(t, "31"), WithdrawableEpoch: beacon.FarFutureEpoch},
+			balance:    "32.0123",
+			epoch:      100,
+			wantKind:   beacon.NoWithdrawal,
+			wantAmount: "0",
+		},
+		{
+			name:       "compounding partial",
+			validator:  beacon.Validator{WithdrawalPrefix: beacon.CompoundingWithdrawalPrefix, EffectiveBalance: ether(t, "2048"), WithdrawableEpoch: beacon.FarFutureEpoch},
+			balance:    "2048.5",
+			epoch:      100,
+			wantKind:   beacon.PartialWithdrawal,
+			wantAmount: "0.5",
+		},
+		{
+			name:       "compounding below cap",
+			validator:  beacon.Validator{WithdrawalPrefix: beacon.CompoundingWithdrawalPrefix, EffectiveBalance: ether(t, "64"), WithdrawableEpoch: beacon.FarFutureEpoch},
+			balance:    "64.5",
+			epoch:      100,
+			wantKind:   beacon.NoWithdrawal,
+			wantAmount: "0",
+		},
+		{
+			name:       "full",
+			validator:  beacon.Validator{WithdrawalPrefix: beacon.ETH1AddressWithdrawalPrefix, EffectiveBalance: ether(t, "32"), WithdrawableEpoch: 100},
+			balance:    "32.0123",
+			epoch:      100,
+			wantKind:   beacon.FullWithdrawal,
+			wantAmount: "32.0123",
+		},
+		{
+			name:       "not yet withdrawable",
+			validator:  beacon.Validator{WithdrawalPrefix: beacon.ETH1AddressWithdrawalPrefix, EffectiveBalance: ether(t, "31"), WithdrawableEpoch: 101},
+			balance:    "31",
+			epoch:      100,
+			wantKind:   beacon.NoWithdrawal,
+			wantAmount: "0",
+		},
+		{
+			name:       "bls credentials",
+			validator:  beacon.Validator{WithdrawalPrefix: beacon.BLSWithdrawalPrefix, EffectiveBalance: ether(t, "32"), WithdrawableEpoch: 0},
+			balance:    "33",
+			epoch:      100,
+			wantKind:   beacon.NoWithdrawal,
+			wantAmount: "0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kind, amount := tt.validator.Withdrawal(ether(t, tt.balance), tt.epoch)
+			assert.Equal(t, tt.wantKind, kind)
+			assert.Equal(t, tt.wantAmount, amount.Ether())
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package ethunits
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Bps is a ratio expressed in basis points, where 10000 bps equal 100%.
+type Bps uint32
+
+// MaxBps is 100% expressed in basis points.
+const MaxBps Bps = 10000
+
+func (b Bps) String() string {
+	return strconv.FormatUint(uint64(b), 10) + "bps"
+}
+
+// ParseBps parses a ratio given either in basis points, with or without a
+// "bps" suffix ("50", "50bps"), or as a percentage ("0.5%").
+// It returns false for negative ratios and for percentages which aren't
+// a whole number of basis points.
+func ParseBps(s string) (Bps, bool) {
+	s = strings.ToLower(strings.TrimSpace(s))
+
+	scale := int64(1)
+	switch {
+	case strings.HasSuffix(s, "%"):
+		s = strings.TrimSuffix(s, "%")
+		scale = 100
+	case strings.HasSuffix(s, "bps"):
+		s = strings.TrimSuffix(s, "bps")
+	case strings.HasSuffix(s, "bp"):
+		s = strings.TrimSuffix(s, "bp")
+	}
+
+	r, ok := parseDecimal(s)
+	if !ok || r.Sign() < 0 {
+		return 0, false
+	}
+
+	r.Mul(r, big.NewRat(scale, 1))
+	if !r.IsInt() || !r.Num().IsUint64() || r.Num().Uint64() > uint64(^uint32(0)) {
+		return 0, false
+	}
+
+	return Bps(r.Num().Uint64()), true
+}
+
+func (b Bps) bigInt() *big.Int {
+	return new(big.Int).SetUint64(uint64(b))
+}
@@ -0,0 +1,416 @@
+// Package calc evaluates arithmetic expressions over amounts of ether,
+// such as "21000 * 35 gwei in ether", using exact rational arithmetic.
+//
+// Expressions are made of numbers, which may be followed by a unit
+// ("1.5 ether", "3e17 wei", "0x4a817c800 wei"), variables, the operators
+// + - * / and parentheses. A trailing "in <unit>" sets the unit the result
+// is displayed in. Statements of the form "name = expression" assign
+// variables.
+//
+// Amounts may be added to and subtracted from amounts, multiplied and divided
+// by numbers, and divided by amounts to give a number. Any other mix of
+// numbers and amounts is reported as ErrDimension.
+package calc
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/jalavosus/go-ethunits"
+)
+
+var (
+	ErrSyntax         = errors.New("syntax error")
+	ErrDimension      = errors.New("incompatible dimensions")
+	ErrDivisionByZero = errors.New("division by zero")
+	ErrUndefined      = errors.New("undefined variable")
+)
+
+// Error describes where and why an expression failed to evaluate.
+// It wraps one of ErrSyntax, ErrDimension, ErrDivisionByZero or ErrUndefined.
+type Error struct {
+	// Pos is the 1-based column of the input at which the error occurred.
+	Pos    int
+	Err    error
+	Detail string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("calc: column %d: %s: %s", e.Pos, e.Err, e.Detail)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Calculator evaluates expressions, keeping the variables they assign.
+// The zero value is ready to use.
+type Calculator struct {
+	vars map[string]Value
+}
+
+// Eval evaluates a single expression or assignment.
+// An assignment returns the assigned value.
+func (c *Calculator) Eval(input string) (Value, error) {
+	tokens, err := lex(input)
+	if err != nil {
+		return Value{}, err
+	}
+
+	p := &parser{tokens: tokens, calc: c}
+
+	var name string
+	if len(tokens) > 2 && tokens[0].kind == tokenIdent && tokens[1].kind == tokenOp && tokens[1].text == "=" {
+		name = tokens[0].text
+		if err := checkVarName(tokens[0]); err != nil {
+			return Value{}, err
+		}
+		p.next = 2
+	}
+
+	v, err := p.parseExpr()
+	if err != nil {
+		return Value{}, err
+	}
+
+	if t := p.peek(); t.kind != tokenEOF {
+		return Value{}, &Error{Pos: t.pos, Err: ErrSyntax, Detail: "unexpected " + quote(t.text)}
+	}
+
+	if name != "" {
+		c.Set(name, v)
+	}
+
+	return v, nil
+}
+
+// Set assigns a variable. The zero Value is the number zero.
+func (c *Calculator) Set(name string, v Value) {
+	if c.vars == nil {
+		c.vars = make(map[string]Value)
+	}
+
+	v.r = v.Rat()
+	c.vars[name] = v
+}
+
+// Get returns the value of a variable.
+func (c *Calculator) Get(name string) (Value, bool) {
+	v, ok := c.vars[name]
+	return v, ok
+}
+
+// Eval evaluates a single expression with no variables defined.
+func Eval(input string) (Value, error) {
+	return new(Calculator).Eval(input)
+}
+
+func checkVarName(t token) error {
+	if _, isUnit := ethunits.ParseUnit(t.text); isUnit || t.text == "in" {
+		return &Error{Pos: t.pos, Err: ErrSyntax, Detail: quote(t.text) + " is reserved"}
+	}
+
+	return nil
+}
+
+type parser struct {
+	tokens []token
+	next   int
+	calc   *Calculator
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.next]
+}
+
+func (p *parser) take() token {
+	t := p.tokens[p.next]
+	if t.kind != tokenEOF {
+		p.next++
+	}
+
+	return t
+}
+
+func (p *parser) isOp(ops ...string) bool {
+	t := p.peek()
+	if t.kind != tokenOp {
+		return false
+	}
+
+	for _, op := range ops {
+		if t.text == op {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseExpr parses sum ["in" unit].
+func (p *parser) parseExpr() (Value, error) {
+	v, err := p.parseSum()
+	if err != nil {
+		return Value{}, err
+	}
+
+	for t := p.peek(); t.kind == tokenIdent && t.text == "in"; t = p.peek() {
+		p.take()
+
+		u, ut, err := p.parseUnit()
+		if err != nil {
+			return Value{}, err
+		}
+
+		if !v.amount {
+			return Value{}, &Error{Pos: t.pos, Err: ErrDimension, Detail: "can't convert a number to " + ut.text}
+		}
+
+		v = v.In(u)
+	}
+
+	return v, nil
+}
+
+func (p *parser) parseUnit() (ethunits.Unit, token, error) {
+	t := p.take()
+	if t.kind != tokenIdent {
+		return ethunits.Unknown, t, &Error{Pos: t.pos, Err: ErrSyntax, Detail: "expected a unit"}
+	}
+
+	u, ok := ethunits.ParseUnit(t.text)
+	if !ok {
+		return ethunits.Unknown, t, &Error{Pos: t.pos, Err: ErrSyntax, Detail: "unknown unit " + quote(t.text)}
+	}
+
+	return u, t, nil
+}
+
+// parseSum parses term (("+" | "-") term)*.
+func (p *parser) parseSum() (Value, error) {
+	v, err := p.parseTerm()
+	if err != nil {
+		return Value{}, err
+	}
+
+	for p.isOp("+", "-") {
+		op := p.take()
+
+		rhs, err := p.parseTerm()
+		if err != nil {
+			return Value{}, err
+		}
+
+		if v.amount != rhs.amount {
+			return Value{}, &Error{Pos: op.pos, Err: ErrDimension, Detail: "can't " + verb(op.text) + " " + describe(rhs) + " " + preposition(op.text) + " " + describe(v)}
+		}
+
+		r := v.Rat()
+		if op.text == "+" {
+			r.Add(r, rhs.Rat())
+		} else {
+			r.Sub(r, rhs.Rat())
+		}
+
+		v = Value{r: r, amount: v.amount, unit: v.unit}
+	}
+
+	return v, nil
+}
+
+// parseTerm parses unary (("*" | "/") unary)*.
+func (p *parser) parseTerm() (Value, error) {
+	v, err := p.parseUnary()
+	if err != nil {
+		return Value{}, err
+	}
+
+	for p.isOp("*", "/") {
+		op := p.take()
+
+		rhs, err := p.parseUnary()
+		if err != nil {
+			return Value{}, err
+		}
+
+		if v, err = arith(op, v, rhs); err != nil {
+			return Value{}, err
+		}
+	}
+
+	return v, nil
+}
+
+func arith(op token, lhs, rhs Value) (Value, error) {
+	r := lhs.Rat()
+
+	if op.text == "*" {
+		if lhs.amount && rhs.amount {
+			return Value{}, &Error{Pos: op.pos, Err: ErrDimension, Detail: "can't multiply two amounts"}
+		}
+
+		unit := lhs.unit
+		if rhs.amount {
+			unit = rhs.unit
+		}
+
+		return Value{r: r.Mul(r, rhs.Rat()), amount: lhs.amount || rhs.amount, unit: unit}, nil
+	}
+
+	if !lhs.amount && rhs.amount {
+		return Value{}, &Error{Pos: op.pos, Err: ErrDimension, Detail: "can't divide a number by an amount"}
+	}
+
+	if rhs.Rat().Sign() == 0 {
+		return Value{}, &Error{Pos: op.pos, Err: ErrDivisionByZero, Detail: "divisor is zero"}
+	}
+
+	// An amount divided by an amount is a number.
+	return Value{r: r.Quo(r, rhs.Rat()), amount: lhs.amount && !rhs.amount, unit: lhs.unit}, nil
+}
+
+// parseUnary parses "-" unary | "+" unary | postfix.
+func (p *parser) parseUnary() (Value, error) {
+	if p.isOp("-", "+") {
+		op := p.take()
+
+		v, err := p.parseUnary()
+		if err != nil {
+			return Value{}, err
+		}
+
+		if op.text == "-" {
+			v.r = new(big.Rat).Neg(v.Rat())
+		}
+
+		return v, nil
+	}
+
+	return p.parsePostfix()
+}
+
+// parsePostfix parses primary [unit].
+func (p *parser) parsePostfix() (Value, error) {
+	v, err := p.parsePrimary()
+	if err != nil {
+		return Value{}, err
+	}
+
+	t := p.peek()
+	if t.kind != tokenIdent || t.text == "in" {
+		return v, nil
+	}
+
+	u, ok := ethunits.ParseUnit(t.text)
+	if !ok {
+		return v, nil
+	}
+	p.take()
+
+	if v.amount {
+		return Value{}, &Error{Pos: t.pos, Err: ErrDimension, Detail: "can't apply unit " + quote(t.text) + " to an amount; use \"in " + t.text + "\" to convert"}
+	}
+
+	wei := new(big.Rat).Mul(v.Rat(), weiPerUnit(u))
+
+	return Value{r: wei, amount: true, unit: u}, nil
+}
+
+// parsePrimary parses number | variable | "(" expr ")".
+func (p *parser) parsePrimary() (Value, error) {
+	t := p.take()
+
+	switch t.kind {
+	case tokenNumber:
+		r, ok := parseNumber(t.text)
+		if !ok {
+			return Value{}, &Error{Pos: t.pos, Err: ErrSyntax, Detail: "invalid number " + quote(t.text)}
+		}
+
+		return Value{r: r}, nil
+	case tokenIdent:
+		if _, isUnit := ethunits.ParseUnit(t.text); isUnit {
+			return Value{}, &Error{Pos: t.pos, Err: ErrSyntax, Detail: "unit " + quote(t.text) + " must follow a number"}
+		}
+
+		v, ok := p.calc.Get(t.text)
+		if !ok {
+			return Value{}, &Error{Pos: t.pos, Err: ErrUndefined, Detail: quote(t.text)}
+		}
+
+		return v, nil
+	case tokenOp:
+		if t.text == "(" {
+			v, err := p.parseExpr()
+			if err != nil {
+				return Value{}, err
+			}
+
+			if closing := p.take(); closing.kind != tokenOp || closing.text != ")" {
+				return Value{}, &Error{Pos: closing.pos, Err: ErrSyntax, Detail: "expected \")\""}
+			}
+
+			return v, nil
+		}
+	case tokenEOF:
+		return Value{}, &Error{Pos: t.pos, Err: ErrSyntax, Detail: "unexpected end of input"}
+	}
+
+	return Value{}, &Error{Pos: t.pos, Err: ErrSyntax, Detail: "unexpected " + quote(t.text)}
+}
+
+func parseNumber(s string) (*big.Rat, bool) {
+	s = strings.ReplaceAll(s, "_", "")
+
+	if hex := strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X"); hex != s {
+		i, ok := new(big.Int).SetString(hex, 16)
+		if !ok {
+			return nil, false
+		}
+
+		return new(big.Rat).SetInt(i), true
+	}
+
+	if strings.Count(s, ".") > 1 || s == "." {
+		return nil, false
+	}
+
+	return new(big.Rat).SetString(s)
+}
+
+// weiPerUnit returns the number of wei in one u.
+func weiPerUnit(u ethunits.Unit) *big.Rat {
+	exp, _ := u.WeiExponent()
+	return new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exp)), nil))
+}
+
+func describe(v Value) string {
+	if v.amount {
+		return "an amount"
+	}
+
+	return "a number"
+}
+
+func verb(op string) string {
+	if op == "+" {
+		return "add"
+	}
+
+	return "subtract"
+}
+
+func preposition(op string) string {
+	if op == "+" {
+		return "to"
+	}
+
+	return "from"
+}
+
+func quote(s string) string {
+	return strconv.Quote(s)
+}
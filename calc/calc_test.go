@@ -0,0 +1,151 @@
+package calc_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jalavosus/go-ethunits"
+	"github.com/jalavosus/go-ethunits/calc"
+)
+
+func TestEval(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{expr: "21000 * 35 gwei", want: "735000 gwei"},
+		{expr: "21000 * 35 gwei in ether", want: "0.000735 ether"},
+		{expr: "(1.5 ether - 3e17 wei) / 3", want: "0.4 ether"},
+		{expr: "(1.5 ether - 3e17 wei) / 3 in finney", want: "400 finney"},
+		{expr: "1 ether / 3", want: "~0.333333333333333333 ether"},
+		{expr: "1 ether / 3 in wei", want: "~333333333333333333.333333333333333333 wei"},
+		{expr: "0x4a817c800 wei in gwei", want: "20 gwei"},
+		{expr: "1 ether / 250 gwei", want: "4000000"},
+		{expr: "-2.5 szabo + 1 szabo", want: "-1.5 szabo"},
+		{expr: "2 * (3 + 4) / 8", want: "1.75"},
+		{expr: "1_000_000 wei in kwei", want: "1000 kwei"},
+		{expr: "(1 + 2) gwei", want: "3 gwei"},
+		{expr: "1 ether in gwei in ether", want: "1 ether"},
+		{expr: "1 ETH - 1 ether", want: "0 ether"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			got, err := calc.Eval(tt.expr)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got.String())
+		})
+	}
+}
+
+func TestEvalErrors(t *testing.T) {
+	tests := []struct {
+		expr    string
+		wantErr error
+		wantPos int
+	}{
+		{expr: "1 ether + 2", wantErr: calc.ErrDimension, wantPos: 9},
+		{expr: "2 - 1 gwei", wantErr: calc.ErrDimension, wantPos: 3},
+		{expr: "1 ether * 2 ether", wantErr: calc.ErrDimension, wantPos: 9},
+		{expr: "2 / 1 ether", wantErr: calc.ErrDimension, wantPos: 3},
+		{expr: "2 in gwei", wantErr: calc.ErrDimension, wantPos: 3},
+		{expr: "(1 ether) gwei", wantErr: calc.ErrDimension, wantPos: 11},
+		{expr: "1 ether / 0", wantErr: calc.ErrDivisionByZero, wantPos: 9},
+		{expr: "x + 1", wantErr: calc.ErrUndefined, wantPos: 1},
+		{expr: "1 ether in dogecoin", wantErr: calc.ErrSyntax, wantPos: 12},
+		{expr: "(1 + 2", wantErr: calc.ErrSyntax, wantPos: 7},
+		{expr: "1 +", wantErr: calc.ErrSyntax, wantPos: 4},
+		{expr: "1 2", wantErr: calc.ErrSyntax, wantPos: 3},
+		{expr: "1.2.3", wantErr: calc.ErrSyntax, wantPos: 1},
+		{expr: "1 % 2", wantErr: calc.ErrSyntax, wantPos: 3},
+		{expr: "gwei", wantErr: calc.ErrSyntax, wantPos: 1},
+		{expr: "ether = 1", wantErr: calc.ErrSyntax, wantPos: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			_, err := calc.Eval(tt.expr)
+			require.ErrorIs(t, err, tt.wantErr)
+
+			var calcErr *calc.Error
+			require.ErrorAs(t, err, &calcErr)
+			assert.Equal(t, tt.wantPos, calcErr.Pos, err.Error())
+		})
+	}
+
+	_, err := calc.Eval("1 ether + 2")
+	assert.EqualError(t, err, "calc: column 9: incompatible dimensions: can't add a number to an amount")
+}
+
+func TestCalculatorVariables(t *testing.T) {
+	var c calc.Calculator
+
+	v, err := c.Eval("gasPrice = 35 gwei")
+	require.NoError(t, err)
+	assert.Equal(t, "35 gwei", v.String())
+
+	_, err = c.Eval("gas = 21000")
+	require.NoError(t, err)
+
+	v, err = c.Eval("gas * gasPrice in ether")
+	require.NoError(t, err)
+	assert.Equal(t, "0.000735 ether", v.String())
+	assert.True(t, v.IsAmount())
+	assert.Equal(t, ethunits.Ether, v.Unit())
+
+	wei, ok := v.Wei()
+	require.True(t, ok)
+	assert.Equal(t, big.NewInt(735000000000000), wei)
+
+	c.Set("fee", calc.Amount(big.NewRat(1e9, 1), ethunits.GWei))
+	v, err = c.Eval("fee / gasPrice")
+	require.NoError(t, err)
+	assert.False(t, v.IsAmount())
+	assert.Equal(t, big.NewRat(1, 35), v.Rat())
+	assert.Equal(t, ethunits.Unknown, v.Unit())
+
+	_, ok = v.Wei()
+	assert.False(t, ok)
+
+	assert.Equal(t, "42", calc.Number(big.NewRat(42, 1)).String())
+}
+
+func TestCalculatorZeroValue(t *testing.T) {
+	var c calc.Calculator
+	c.Set("x", calc.Value{})
+
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{expr: "x", want: "0"},
+		{expr: "-x", want: "0"},
+		{expr: "x + 1", want: "1"},
+		{expr: "1 - x", want: "1"},
+		{expr: "x * 2", want: "0"},
+		{expr: "x / 2", want: "0"},
+		{expr: "x gwei", want: "0 gwei"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			got, err := c.Eval(tt.expr)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got.String())
+		})
+	}
+
+	_, err := c.Eval("1 / x")
+	assert.ErrorIs(t, err, calc.ErrDivisionByZero)
+
+	_, err = c.Eval("x in gwei")
+	assert.ErrorIs(t, err, calc.ErrDimension)
+
+	// Values built outside the calculator are nil-safe too.
+	var zero calc.Value
+	assert.Equal(t, "0", zero.String())
+	assert.Equal(t, new(big.Rat), zero.Rat())
+}
@@ -0,0 +1,78 @@
+package calc
+
+import (
+	"unicode"
+)
+
+type tokenKind uint8
+
+const (
+	tokenEOF tokenKind = iota
+	tokenNumber
+	tokenIdent
+	tokenOp
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	// pos is the 1-based column of the token in the input.
+	pos int
+}
+
+// lex splits an expression into tokens.
+func lex(input string) ([]token, error) {
+	var (
+		tokens []token
+		runes  = []rune(input)
+	)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		start := i
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+			continue
+		case r == '0' && i+1 < len(runes) && (runes[i+1] == 'x' || runes[i+1] == 'X'):
+			i += 2
+			for i < len(runes) && (isHexDigit(runes[i]) || runes[i] == '_') {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: string(runes[start:i]), pos: start + 1})
+		case unicode.IsDigit(r) || r == '.':
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.' || runes[i] == '_') {
+				i++
+			}
+			// An exponent, as in 3e17 or 2.5E-3.
+			if i < len(runes) && (runes[i] == 'e' || runes[i] == 'E') {
+				j := i + 1
+				if j < len(runes) && (runes[j] == '+' || runes[j] == '-') {
+					j++
+				}
+				if j < len(runes) && unicode.IsDigit(runes[j]) {
+					for i = j; i < len(runes) && unicode.IsDigit(runes[i]); i++ {
+					}
+				}
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: string(runes[start:i]), pos: start + 1})
+		case unicode.IsLetter(r) || r == '_':
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_') {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: string(runes[start:i]), pos: start + 1})
+		case r == '+' || r == '-' || r == '*' || r == '/' || r == '(' || r == ')' || r == '=':
+			i++
+			tokens = append(tokens, token{kind: tokenOp, text: string(r), pos: start + 1})
+		default:
+			return nil, &Error{Pos: start + 1, Err: ErrSyntax, Detail: "unexpected character " + quote(string(r))}
+		}
+	}
+
+	return append(tokens, token{kind: tokenEOF, pos: len(runes) + 1}), nil
+}
+
+func isHexDigit(r rune) bool {
+	return unicode.IsDigit(r) || ('a' <= r && r <= 'f') || ('A' <= r && r <= 'F')
+}
@@ -0,0 +1,133 @@
+package calc
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/jalavosus/go-ethunits"
+)
+
+// maxDisplayDecimals is the number of decimal places shown for values
+// which have no finite decimal representation, such as 1/3.
+const maxDisplayDecimals = 18
+
+// Value is the result of evaluating an expression: either a dimensionless
+// number or an amount of currency, which is displayed in a Unit.
+// The zero Value is the number zero.
+type Value struct {
+	r      *big.Rat
+	amount bool
+	unit   ethunits.Unit
+}
+
+// Number returns a dimensionless Value.
+func Number(r *big.Rat) Value {
+	return Value{r: new(big.Rat).Set(r)}
+}
+
+// Amount returns a Value holding an amount of wei, displayed in u.
+func Amount(wei *big.Rat, u ethunits.Unit) Value {
+	return Value{r: new(big.Rat).Set(wei), amount: true, unit: u}
+}
+
+// IsAmount reports whether v is an amount of currency.
+func (v Value) IsAmount() bool {
+	return v.amount
+}
+
+// Rat returns v as an exact fraction: an amount of wei if v is an amount.
+func (v Value) Rat() *big.Rat {
+	if v.r == nil {
+		return new(big.Rat)
+	}
+
+	return new(big.Rat).Set(v.r)
+}
+
+// Unit returns the unit v is displayed in, or Unknown if v isn't an amount.
+func (v Value) Unit() ethunits.Unit {
+	if !v.amount {
+		return ethunits.Unknown
+	}
+
+	return v.unit
+}
+
+// Wei returns v as a whole amount of wei. It returns false if v isn't an
+// amount or isn't a whole number of wei.
+func (v Value) Wei() (*big.Int, bool) {
+	if !v.amount || !v.Rat().IsInt() {
+		return nil, false
+	}
+
+	return new(big.Int).Set(v.Rat().Num()), true
+}
+
+// In returns the amount v displayed in u.
+func (v Value) In(u ethunits.Unit) Value {
+	v.unit = u
+	return v
+}
+
+// String renders v as an exact decimal, followed by its unit if v is an
+// amount, e.g. "0.000735 ether". Values without a finite decimal
+// representation are rounded to 18 decimal places and prefixed with "~".
+func (v Value) String() string {
+	r := v.Rat()
+	if v.amount {
+		r.Quo(r, weiPerUnit(v.unit))
+	}
+
+	s := formatRat(r)
+	if v.amount {
+		s += " " + strings.ToLower(v.unit.String())
+	}
+
+	return s
+}
+
+// formatRat renders r exactly if it has a finite decimal representation.
+func formatRat(r *big.Rat) string {
+	places, exact := decimalPlaces(r.Denom())
+	if !exact {
+		return "~" + trimZeros(r.FloatString(maxDisplayDecimals))
+	}
+
+	return trimZeros(r.FloatString(places))
+}
+
+// decimalPlaces returns the number of decimal places needed to represent
+// fractions with denominator d exactly, or false if there is no such number.
+func decimalPlaces(d *big.Int) (int, bool) {
+	d = new(big.Int).Set(d)
+
+	var twos, fives int
+	for two := big.NewInt(2); new(big.Int).Rem(d, two).Sign() == 0; twos++ {
+		d.Quo(d, two)
+	}
+	for five := big.NewInt(5); new(big.Int).Rem(d, five).Sign() == 0; fives++ {
+		d.Quo(d, five)
+	}
+
+	if d.Cmp(big.NewInt(1)) != 0 {
+		return 0, false
+	}
+
+	if twos > fives {
+		return twos, true
+	}
+
+	return fives, true
+}
+
+func trimZeros(s string) string {
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+
+	if s == "-0" || s == "~-0" {
+		s = strings.Replace(s, "-", "", 1)
+	}
+
+	return s
+}
@@ -0,0 +1,205 @@
+// Package chainlink validates Chainlink price feed answers and uses them to
+// value token amounts, composing feeds exactly.
+package chainlink
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/jalavosus/go-ethunits"
+)
+
+var (
+	ErrInvalidAnswer   = errors.New("chainlink: answer must be positive")
+	ErrIncompleteRound = errors.New("chainlink: round is not complete")
+	ErrStaleRound      = errors.New("chainlink: answer was carried over from an earlier round")
+	ErrStaleAnswer     = errors.New("chainlink: answer is older than the feed's heartbeat")
+	ErrFeedMismatch    = errors.New("chainlink: feeds can't be composed")
+	ErrTooManyDecimals = errors.New("chainlink: composed price has more than 255 decimals")
+)
+
+// RoundData holds the values returned by an aggregator's latestRoundData()
+// or getRoundData() functions. StartedAt and UpdatedAt are unix timestamps.
+type RoundData struct {
+	RoundID         *big.Int
+	Answer          *big.Int
+	StartedAt       uint64
+	UpdatedAt       uint64
+	AnsweredInRound *big.Int
+}
+
+// Feed describes a price feed, which quotes Base in Quote, e.g. ETH / USD.
+type Feed struct {
+	Base  string
+	Quote string
+	// Decimals is the value returned by the aggregator's decimals(),
+	// typically 8 for USD feeds and 18 for ETH feeds.
+	Decimals uint8
+	// Heartbeat is the longest time the feed may go without an update.
+	// A zero Heartbeat disables the staleness check.
+	Heartbeat time.Duration
+}
+
+func (f Feed) String() string {
+	return f.Base + " / " + f.Quote
+}
+
+// Price validates a round of the feed as of now and returns its answer.
+// It returns ErrInvalidAnswer if the answer isn't positive,
+// ErrIncompleteRound if the round hasn't been updated,
+// ErrStaleRound if the answer was computed in an earlier round,
+// and ErrStaleAnswer if the answer is older than the feed's heartbeat.
+func (f Feed) Price(round RoundData, now time.Time) (Price, error) {
+	switch {
+	case round.Answer == nil || round.Answer.Sign() <= 0:
+		return Price{}, fmt.Errorf("%s: %w", f, ErrInvalidAnswer)
+	case round.UpdatedAt == 0:
+		return Price{}, fmt.Errorf("%s: %w", f, ErrIncompleteRound)
+	case round.RoundID != nil && round.AnsweredInRound != nil && round.AnsweredInRound.Cmp(round.RoundID) < 0:
+		return Price{}, fmt.Errorf("%s: %w", f, ErrStaleRound)
+	}
+
+	updatedAt := time.Unix(int64(round.UpdatedAt), 0)
+	if f.Heartbeat > 0 && now.Sub(updatedAt) > f.Heartbeat {
+		return Price{}, fmt.Errorf("%s: %w: updated %s ago", f, ErrStaleAnswer, now.Sub(updatedAt))
+	}
+
+	return Price{
+		Base:      f.Base,
+		Quote:     f.Quote,
+		Answer:    new(big.Int).Set(round.Answer),
+		Decimals:  f.Decimals,
+		UpdatedAt: updatedAt,
+	}, nil
+}
+
+// Price is a validated feed answer: one whole unit of Base is worth
+// Answer / 10^Decimals units of Quote.
+type Price struct {
+	Base     string
+	Quote    string
+	Answer   *big.Int
+	Decimals uint8
+	// UpdatedAt is the time the answer was last updated. For a composed
+	// price it is the time of the oldest answer.
+	UpdatedAt time.Time
+}
+
+// Rat returns the price as an exact fraction.
+func (p Price) Rat() *big.Rat {
+	return new(big.Rat).SetFrac(p.Answer, pow10(int(p.Decimals)))
+}
+
+// String renders the price, e.g. "1 ETH = 3123.45 USD".
+func (p Price) String() string {
+	return "1 " + p.Base + " = " + ethunits.FormatUnits(p.Answer, p.Decimals) + " " + p.Quote
+}
+
+// Scaled returns the answer normalized to the given number of decimals,
+// rounding in the given direction when precision is lost. Unlike on-chain
+// arithmetic, the result isn't limited to uint256.
+func (p Price) Scaled(decimals uint8, rounding ethunits.Rounding) (*big.Int, error) {
+	if decimals >= p.Decimals {
+		return new(big.Int).Mul(p.Answer, pow10(int(decimals-p.Decimals))), nil
+	}
+
+	return quo(p.Answer, pow10(int(p.Decimals-decimals)), rounding), nil
+}
+
+// Mul composes p with a price of p's Quote in another currency, e.g.
+// LINK / ETH × ETH / USD gives LINK / USD. The result is exact: its
+// decimals are the sum of both prices' decimals.
+func (p Price) Mul(q Price) (Price, error) {
+	if !strings.EqualFold(p.Quote, q.Base) {
+		return Price{}, fmt.Errorf("%w: %s / %s × %s / %s", ErrFeedMismatch, p.Base, p.Quote, q.Base, q.Quote)
+	}
+
+	decimals := int(p.Decimals) + int(q.Decimals)
+	if decimals > 255 {
+		return Price{}, ErrTooManyDecimals
+	}
+
+	updatedAt := p.UpdatedAt
+	if q.UpdatedAt.Before(updatedAt) {
+		updatedAt = q.UpdatedAt
+	}
+
+	return Price{
+		Base:      p.Base,
+		Quote:     q.Quote,
+		Answer:    new(big.Int).Mul(p.Answer, q.Answer),
+		Decimals:  uint8(decimals),
+		UpdatedAt: updatedAt,
+	}, nil
+}
+
+// Compose multiplies a chain of prices, each quoted in the base of the next.
+func Compose(prices ...Price) (Price, error) {
+	if len(prices) == 0 {
+		return Price{}, ErrFeedMismatch
+	}
+
+	composed := prices[0]
+	for _, p := range prices[1:] {
+		var err error
+		if composed, err = composed.Mul(p); err != nil {
+			return Price{}, err
+		}
+	}
+
+	return composed, nil
+}
+
+// Value returns the exact value in Quote of a raw amount of Base with the
+// given decimals.
+func (p Price) Value(amount *big.Int, decimals uint8) *big.Rat {
+	v := new(big.Rat).SetFrac(amount, pow10(int(decimals)))
+	return v.Mul(v, p.Rat())
+}
+
+// ValueUnits returns the value in Quote of a raw amount of Base with the given
+// decimals, as a raw amount of Quote with outDecimals decimals, rounded in the
+// given direction. Like Scaled, the result isn't limited to uint256, so
+// composed prices with many decimals are valued exactly.
+func (p Price) ValueUnits(amount *big.Int, decimals, outDecimals uint8, rounding ethunits.Rounding) (*big.Int, error) {
+	num := new(big.Int).Mul(amount, p.Answer)
+	num.Mul(num, pow10(int(outDecimals)))
+
+	return quo(num, pow10(int(decimals)+int(p.Decimals)), rounding), nil
+}
+
+// FormatValue renders the value of a raw amount of Base with the given
+// decimals in Quote, rounded down to prec decimal places, e.g. "4685.18 USD".
+func (p Price) FormatValue(amount *big.Int, decimals uint8, prec uint8) (string, error) {
+	v, err := p.ValueUnits(amount, decimals, prec, ethunits.RoundFloor)
+	if err != nil {
+		return "", err
+	}
+
+	return ethunits.FormatUnits(v, prec) + " " + p.Quote, nil
+}
+
+// quo returns x / y for a positive y, rounded in the given direction.
+func quo(x, y *big.Int, rounding ethunits.Rounding) *big.Int {
+	q, r := new(big.Int).QuoRem(x, y, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+
+	// q is truncated toward zero; step away from zero where needed.
+	switch {
+	case rounding == ethunits.RoundExpand,
+		rounding == ethunits.RoundCeil && x.Sign() > 0,
+		rounding == ethunits.RoundFloor && x.Sign() < 0:
+		q.Add(q, big.NewInt(int64(x.Sign())))
+	}
+
+	return q
+}
+
+func pow10(exp int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exp)), nil)
+}
@@ -0,0 +1,148 @@
+package chainlink_test
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jalavosus/go-ethunits"
+	"github.com/jalavosus/go-ethunits/chainlink"
+)
+
+var (
+	now = time.Unix(1_714_521_600, 0)
+
+	ethUSD  = chainlink.Feed{Base: "ETH", Quote: "USD", Decimals: 8, Heartbeat: time.Hour}
+	linkETH = chainlink.Feed{Base: "LINK", Quote: "ETH", Decimals: 18, Heartbeat: 24 * time.Hour}
+)
+
+func round(answer string, updatedAt time.Time) chainlink.RoundData {
+	a, _ := new(big.Int).SetString(answer, 10)
+
+	return chainlink.RoundData{
+		RoundID:         big.NewInt(7205759403792801234),
+		Answer:          a,
+		StartedAt:       uint64(updatedAt.Unix()),
+		UpdatedAt:       uint64(updatedAt.Unix()),
+		AnsweredInRound: big.NewInt(7205759403792801234),
+	}
+}
+
+func TestFeedPrice(t *testing.T) {
+	p, err := ethUSD.Price(round("312345000000", now.Add(-10*time.Minute)), now)
+	require.NoError(t, err)
+	assert.Equal(t, "1 ETH = 3123.45 USD", p.String())
+	assert.Equal(t, big.NewRat(312345, 100), p.Rat())
+	assert.Equal(t, now.Add(-10*time.Minute), p.UpdatedAt)
+
+	tests := []struct {
+		name  string
+		round chainlink.RoundData
+		want  error
+	}{
+		{name: "negative answer", round: round("-1", now), want: chainlink.ErrInvalidAnswer},
+		{name: "zero answer", round: round("0", now), want: chainlink.ErrInvalidAnswer},
+		{name: "stale answer", round: round("312345000000", now.Add(-61*time.Minute)), want: chainlink.ErrStaleAnswer},
+		{
+			name: "incomplete round",
+			round: func() chainlink.RoundData {
+				r := round("312345000000", now)
+				r.UpdatedAt = 0
+				return r
+			}(),
+			want: chainlink.ErrIncompleteRound,
+		},
+		{
+			name: "carried over round",
+			round: func() chainlink.RoundData {
+				r := round("312345000000", now)
+				r.AnsweredInRound = new(big.Int).Sub(r.RoundID, big.NewInt(1))
+				return r
+			}(),
+			want: chainlink.ErrStaleRound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ethUSD.Price(tt.round, now)
+			assert.ErrorIs(t, err, tt.want)
+		})
+	}
+
+	noHeartbeat := ethUSD
+	noHeartbeat.Heartbeat = 0
+	_, err = noHeartbeat.Price(round("312345000000", now.Add(-30*24*time.Hour)), now)
+	assert.NoError(t, err)
+}
+
+func TestPriceScaled(t *testing.T) {
+	p, err := ethUSD.Price(round("312345000000", now), now)
+	require.NoError(t, err)
+
+	tests := []struct {
+		decimals uint8
+		rounding ethunits.Rounding
+		want     string
+	}{
+		{decimals: 18, want: "3123450000000000000000"},
+		{decimals: 8, want: "312345000000"},
+		{decimals: 2, want: "312345"},
+		{decimals: 0, rounding: ethunits.RoundFloor, want: "3123"},
+		{decimals: 0, rounding: ethunits.RoundCeil, want: "3124"},
+	}
+
+	for _, tt := range tests {
+		got, err := p.Scaled(tt.decimals, tt.rounding)
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, got.String())
+	}
+}
+
+func TestCompose(t *testing.T) {
+	usd, err := ethUSD.Price(round("312345000000", now.Add(-5*time.Minute)), now)
+	require.NoError(t, err)
+
+	link, err := linkETH.Price(round("5100000000000000", now.Add(-2*time.Hour)), now)
+	require.NoError(t, err)
+
+	linkUSD, err := chainlink.Compose(link, usd)
+	require.NoError(t, err)
+	assert.Equal(t, "1 LINK = 15.929595 USD", linkUSD.String())
+	assert.Equal(t, uint8(26), linkUSD.Decimals)
+	assert.Equal(t, now.Add(-2*time.Hour), linkUSD.UpdatedAt)
+
+	hundred, _ := new(big.Int).SetString("100000000000000000000", 10)
+	assert.Equal(t, big.NewRat(159295950, 100000), linkUSD.Value(hundred, 18))
+
+	v, err := linkUSD.ValueUnits(hundred, 18, 6, ethunits.RoundFloor)
+	require.NoError(t, err)
+	assert.Equal(t, "1592959500", v.String())
+
+	s, err := linkUSD.FormatValue(hundred, 18, 2)
+	require.NoError(t, err)
+	assert.Equal(t, "1592.95 USD", s)
+
+	// Composed prices with many decimals exceed uint256 in intermediate
+	// and final values.
+	precise, err := linkUSD.Mul(chainlink.Price{Base: "USD", Quote: "EUR", Answer: new(big.Int).Exp(big.NewInt(10), big.NewInt(70), nil), Decimals: 70})
+	require.NoError(t, err)
+
+	v, err = precise.ValueUnits(hundred, 18, 80, ethunits.RoundFloor)
+	require.NoError(t, err)
+	assert.Equal(t, "15929595"+strings.Repeat("0", 76), v.String())
+
+	scaled, err := precise.Scaled(90, ethunits.RoundCeil)
+	require.NoError(t, err)
+	assert.Equal(t, "15929595"+strings.Repeat("0", 84), scaled.String())
+
+	_, err = usd.Mul(link)
+	assert.ErrorIs(t, err, chainlink.ErrFeedMismatch)
+
+	_, err = chainlink.Compose()
+	assert.ErrorIs(t, err, chainlink.ErrFeedMismatch)
+}
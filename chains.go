@@ -0,0 +1,131 @@
+package ethunits
+
+import (
+	"math/big"
+	"sort"
+	"sync"
+)
+
+// NativeCurrency describes the currency a chain uses to pay for gas.
+type NativeCurrency struct {
+	Name     string
+	Symbol   string
+	Decimals uint8
+}
+
+// Chain describes an EVM chain and its native currency.
+type Chain struct {
+	ID             uint64
+	Name           string
+	NativeCurrency NativeCurrency
+}
+
+// Well-known chain IDs.
+const (
+	ChainEthereum  uint64 = 1
+	ChainOptimism  uint64 = 10
+	ChainCronos    uint64 = 25
+	ChainBSC       uint64 = 56
+	ChainGnosis    uint64 = 100
+	ChainPolygon   uint64 = 137
+	ChainFantom    uint64 = 250
+	ChainZkSync    uint64 = 324
+	ChainMoonbeam  uint64 = 1284
+	ChainMantle    uint64 = 5000
+	ChainBase      uint64 = 8453
+	ChainHolesky   uint64 = 17000
+	ChainArbitrum  uint64 = 42161
+	ChainCelo      uint64 = 42220
+	ChainAvalanche uint64 = 43114
+	ChainLinea     uint64 = 59144
+	ChainAmoy      uint64 = 80002
+	ChainScroll    uint64 = 534352
+	ChainSepolia   uint64 = 11155111
+)
+
+var (
+	nativeEther = NativeCurrency{Name: "Ether", Symbol: "ETH", Decimals: 18}
+	nativePOL   = NativeCurrency{Name: "POL", Symbol: "POL", Decimals: 18}
+)
+
+var (
+	chainsMu sync.RWMutex
+	chains   = map[uint64]Chain{
+		ChainEthereum:  {ID: ChainEthereum, Name: "Ethereum", NativeCurrency: nativeEther},
+		ChainOptimism:  {ID: ChainOptimism, Name: "OP Mainnet", NativeCurrency: nativeEther},
+		ChainCronos:    {ID: ChainCronos, Name: "Cronos", NativeCurrency: NativeCurrency{Name: "Cronos", Symbol: "CRO", Decimals: 18}},
+		ChainBSC:       {ID: ChainBSC, Name: "BNB Smart Chain", NativeCurrency: NativeCurrency{Name: "BNB", Symbol: "BNB", Decimals: 18}},
+		ChainGnosis:    {ID: ChainGnosis, Name: "Gnosis", NativeCurrency: NativeCurrency{Name: "xDAI", Symbol: "xDAI", Decimals: 18}},
+		ChainPolygon:   {ID: ChainPolygon, Name: "Polygon", NativeCurrency: nativePOL},
+		ChainFantom:    {ID: ChainFantom, Name: "Fantom", NativeCurrency: NativeCurrency{Name: "Fantom", Symbol: "FTM", Decimals: 18}},
+		ChainZkSync:    {ID: ChainZkSync, Name: "zkSync Era", NativeCurrency: nativeEther},
+		ChainMoonbeam:  {ID: ChainMoonbeam, Name: "Moonbeam", NativeCurrency: NativeCurrency{Name: "Glimmer", Symbol: "GLMR", Decimals: 18}},
+		ChainMantle:    {ID: ChainMantle, Name: "Mantle", NativeCurrency: NativeCurrency{Name: "Mantle", Symbol: "MNT", Decimals: 18}},
+		ChainBase:      {ID: ChainBase, Name: "Base", NativeCurrency: nativeEther},
+		ChainHolesky:   {ID: ChainHolesky, Name: "Holesky", NativeCurrency: nativeEther},
+		ChainArbitrum:  {ID: ChainArbitrum, Name: "Arbitrum One", NativeCurrency: nativeEther},
+		ChainCelo:      {ID: ChainCelo, Name: "Celo", NativeCurrency: NativeCurrency{Name: "Celo", Symbol: "CELO", Decimals: 18}},
+		ChainAvalanche: {ID: ChainAvalanche, Name: "Avalanche C-Chain", NativeCurrency: NativeCurrency{Name: "Avalanche", Symbol: "AVAX", Decimals: 18}},
+		ChainLinea:     {ID: ChainLinea, Name: "Linea", NativeCurrency: nativeEther},
+		ChainAmoy:      {ID: ChainAmoy, Name: "Polygon Amoy", NativeCurrency: nativePOL},
+		ChainScroll:    {ID: ChainScroll, Name: "Scroll", NativeCurrency: nativeEther},
+		ChainSepolia:   {ID: ChainSepolia, Name: "Sepolia", NativeCurrency: nativeEther},
+	}
+)
+
+// ChainByID returns the chain registered with the given chain ID.
+func ChainByID(id uint64) (Chain, bool) {
+	chainsMu.RLock()
+	defer chainsMu.RUnlock()
+
+	c, ok := chains[id]
+	return c, ok
+}
+
+// RegisterChain adds a chain to the chain registry,
+// replacing any chain previously registered with the same ID.
+func RegisterChain(c Chain) {
+	chainsMu.Lock()
+	defer chainsMu.Unlock()
+
+	chains[c.ID] = c
+}
+
+// Chains returns every registered chain, ordered by chain ID.
+func Chains() []Chain {
+	chainsMu.RLock()
+	defer chainsMu.RUnlock()
+
+	list := make([]Chain, 0, len(chains))
+	for _, c := range chains {
+		list = append(list, c)
+	}
+
+	sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+
+	return list
+}
+
+// FormatEther renders an amount returned by ToEther as a decimal string
+// followed by the chain's native currency symbol, e.g. "1.5 POL".
+func (c Chain) FormatEther(amount *big.Float) string {
+	return formatFloat(amount) + " " + c.NativeCurrency.Symbol
+}
+
+// FormatWei renders an amount of Wei in the chain's native currency,
+// e.g. "1.5 POL".
+func (c Chain) FormatWei(amount *big.Int) string {
+	return FormatUnits(amount, c.NativeCurrency.Decimals) + " " + c.NativeCurrency.Symbol
+}
+
+// FormatEther renders an amount returned by ToEther as a decimal string
+// followed by the symbol of the native currency of the chain with the given ID.
+// It returns false if no chain is registered with that ID.
+func FormatEther(amount *big.Float, chainID uint64) (string, bool) {
+	c, ok := ChainByID(chainID)
+	if !ok {
+		return "", false
+	}
+
+	return c.FormatEther(amount), true
+}
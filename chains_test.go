@@ -0,0 +1,59 @@
+package ethunits_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jalavosus/go-ethunits"
+)
+
+func TestFormatEther(t *testing.T) {
+	tests := []struct {
+		name    string
+		chainID uint64
+		want    string
+		wantOk  bool
+	}{
+		{name: "chain=ethereum", chainID: ethunits.ChainEthereum, want: wantEtherStr + " ETH", wantOk: true},
+		{name: "chain=polygon", chainID: ethunits.ChainPolygon, want: wantEtherStr + " POL", wantOk: true},
+		{name: "chain=bsc", chainID: ethunits.ChainBSC, want: wantEtherStr + " BNB", wantOk: true},
+		{name: "chain=gnosis", chainID: ethunits.ChainGnosis, want: wantEtherStr + " xDAI", wantOk: true},
+		{name: "chain=avalanche", chainID: ethunits.ChainAvalanche, want: wantEtherStr + " AVAX", wantOk: true},
+		{name: "chain=arbitrum", chainID: ethunits.ChainArbitrum, want: wantEtherStr + " ETH", wantOk: true},
+		{name: "chain=unknown", chainID: 999999999, wantOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ether, ok := ethunits.ToEther(wantWeiStr, ethunits.Wei)
+			require.True(t, ok)
+
+			got, gotOk := ethunits.FormatEther(ether, tt.chainID)
+			assert.Equal(t, tt.wantOk, gotOk)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRegisterChain(t *testing.T) {
+	const chainID uint64 = 424242
+
+	_, ok := ethunits.ChainByID(chainID)
+	require.False(t, ok)
+
+	ethunits.RegisterChain(ethunits.Chain{
+		ID:             chainID,
+		Name:           "Testnet",
+		NativeCurrency: ethunits.NativeCurrency{Name: "Test", Symbol: "TST", Decimals: 6},
+	})
+
+	c, ok := ethunits.ChainByID(chainID)
+	require.True(t, ok)
+	assert.Equal(t, "1.5 TST", c.FormatWei(makeBigInt("1500000")))
+	assert.Equal(t, chainID, c.ID)
+
+	chains := ethunits.Chains()
+	assert.Equal(t, ethunits.ChainEthereum, chains[0].ID)
+}
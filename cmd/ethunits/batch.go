@@ -0,0 +1,361 @@
+package main
+
+import (
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/jalavosus/go-ethunits"
+)
+
+var errBatchUsage = errors.New("usage: ethunits batch [flags] [file ...]")
+
+// batchOptions configures a batch conversion.
+type batchOptions struct {
+	from, to       ethunits.Unit
+	columns        []string
+	decimalsColumn string
+	precision      int
+	comma          rune
+	header         bool
+}
+
+// batchConverter converts the selected columns of the rows of CSV files,
+// reporting malformed rows instead of aborting.
+type batchConverter struct {
+	batchOptions
+
+	w      *csv.Writer
+	stderr io.Writer
+
+	// written is the header row written to the output, taken from the
+	// first input; every later input must have the same header.
+	written []string
+
+	// malformed is the number of rows which couldn't be converted.
+	malformed int
+}
+
+func runBatch(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("ethunits batch", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	var (
+		columns        = fs.String("columns", "", "comma-separated `names` or 1-based indices of the columns to convert")
+		from           = fs.String("from", "wei", "`unit` of the amounts in the converted columns")
+		to             = fs.String("to", "ether", "`unit` to convert amounts into")
+		decimalsColumn = fs.String("decimals-column", "", "`column` holding the token decimals of each row; amounts are raw token amounts and -from and -to are ignored")
+		precision      = fs.Int("precision", -1, "round converted amounts half away from zero to `n` decimal places; exact if negative")
+		tsv            = fs.Bool("tsv", false, "read and write tab-separated values")
+		noHeader       = fs.Bool("no-header", false, "the input has no header row; columns must be given by index")
+	)
+
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, errBatchUsage)
+		fs.PrintDefaults()
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	opts := batchOptions{
+		decimalsColumn: *decimalsColumn,
+		precision:      *precision,
+		comma:          ',',
+		header:         !*noHeader,
+	}
+
+	if *tsv {
+		opts.comma = '\t'
+	}
+
+	for _, c := range strings.Split(*columns, ",") {
+		if c = strings.TrimSpace(c); c != "" {
+			opts.columns = append(opts.columns, c)
+		}
+	}
+
+	var ok bool
+	if opts.from, ok = ethunits.ParseUnit(*from); !ok {
+		fmt.Fprintf(stderr, "ethunits: unknown unit %q\n", *from)
+		return 2
+	}
+
+	if opts.to, ok = ethunits.ParseUnit(*to); !ok {
+		fmt.Fprintf(stderr, "ethunits: unknown unit %q\n", *to)
+		return 2
+	}
+
+	if len(opts.columns) == 0 {
+		fmt.Fprintln(stderr, "ethunits: -columns is required")
+		return 2
+	}
+
+	b := &batchConverter{batchOptions: opts, w: csv.NewWriter(stdout), stderr: stderr}
+	b.w.Comma = opts.comma
+
+	inputs := fs.Args()
+	if len(inputs) == 0 {
+		inputs = []string{"-"}
+	}
+
+	for _, name := range inputs {
+		if err := b.convertFile(name, stdin); err != nil {
+			fmt.Fprintln(stderr, "ethunits:", err)
+			return 1
+		}
+	}
+
+	if b.malformed > 0 {
+		fmt.Fprintf(stderr, "ethunits: skipped %d malformed rows\n", b.malformed)
+		return 1
+	}
+
+	return 0
+}
+
+func (b *batchConverter) convertFile(name string, stdin io.Reader) error {
+	if name == "-" {
+		return b.convert("<stdin>", stdin)
+	}
+
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return b.convert(name, f)
+}
+
+// convert streams the rows of r to the output, converting the selected
+// columns. Rows which can't be parsed or converted are reported, with their
+// line number, and left out of the output.
+func (b *batchConverter) convert(name string, r io.Reader) error {
+	cr := csv.NewReader(r)
+	cr.Comma = b.comma
+	cr.ReuseRecord = true
+
+	var (
+		columns     []int
+		decimalsCol = -1
+		first       = true
+	)
+
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			if first && b.header {
+				return fmt.Errorf("%s:%d: invalid header: %w", name, parseErr.Line, parseErr.Err)
+			}
+			b.reportf(name, parseErr.Line, "%s", parseErr.Err)
+			continue
+		} else if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+
+		line, _ := cr.FieldPos(0)
+
+		if first {
+			first = false
+
+			var header []string
+			if b.header {
+				header = record
+			}
+
+			if columns, err = b.resolveColumns(header, len(record)); err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+
+			if b.decimalsColumn != "" {
+				if decimalsCol, err = resolveColumn(header, len(record), b.decimalsColumn); err != nil {
+					return fmt.Errorf("%s: %w", name, err)
+				}
+			}
+
+			if b.header {
+				if err := b.writeHeader(name, record); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+
+		if err := b.convertRecord(record, columns, decimalsCol); err != nil {
+			b.reportf(name, line, "%s", err)
+			continue
+		}
+
+		if err := b.w.Write(record); err != nil {
+			return err
+		}
+	}
+
+	b.w.Flush()
+
+	return b.w.Error()
+}
+
+// writeHeader writes the header of the first input, and checks that the
+// headers of later inputs match it.
+func (b *batchConverter) writeHeader(name string, header []string) error {
+	if b.written != nil {
+		if !equalFields(header, b.written) {
+			return fmt.Errorf("%s: header doesn't match the header of the first input", name)
+		}
+		return nil
+	}
+
+	b.written = append([]string{}, header...)
+
+	return b.w.Write(header)
+}
+
+func equalFields(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (b *batchConverter) convertRecord(record []string, columns []int, decimalsCol int) error {
+	decimals := -1
+	if decimalsCol >= 0 {
+		d, err := strconv.ParseUint(strings.TrimSpace(record[decimalsCol]), 10, 8)
+		if err != nil {
+			return fmt.Errorf("column %d: invalid decimals %q", decimalsCol+1, record[decimalsCol])
+		}
+		decimals = int(d)
+	}
+
+	converted := make([]string, len(columns))
+	for i, c := range columns {
+		v, err := b.convertValue(record[c], decimals)
+		if err != nil {
+			return fmt.Errorf("column %d: %w", c+1, err)
+		}
+		converted[i] = v
+	}
+
+	for i, c := range columns {
+		record[c] = converted[i]
+	}
+
+	return nil
+}
+
+// convertValue converts an amount of b.from into b.to, or a raw token amount
+// into whole tokens if decimals isn't negative.
+func (b *batchConverter) convertValue(value string, decimals int) (string, error) {
+	if decimals >= 0 {
+		raw, ok := ethunits.ParseUnits(value, 0)
+		if !ok {
+			return "", fmt.Errorf("invalid raw amount %q", value)
+		}
+
+		return formatFixed(raw, decimals, b.precision), nil
+	}
+
+	wei, ok := ethunits.ParseAmount(value, b.from)
+	if !ok {
+		return "", fmt.Errorf("invalid amount %q", value)
+	}
+
+	exp, _ := b.to.WeiExponent()
+
+	return formatFixed(wei, exp, b.precision), nil
+}
+
+func (b *batchConverter) resolveColumns(header []string, n int) ([]int, error) {
+	columns := make([]int, 0, len(b.columns))
+	for _, name := range b.columns {
+		c, err := resolveColumn(header, n, name)
+		if err != nil {
+			return nil, err
+		}
+		columns = append(columns, c)
+	}
+
+	return columns, nil
+}
+
+// resolveColumn returns the 0-based index of the column with the given
+// header name or 1-based index.
+func resolveColumn(header []string, n int, name string) (int, error) {
+	for i, h := range header {
+		if strings.TrimSpace(h) == name {
+			return i, nil
+		}
+	}
+
+	i, err := strconv.Atoi(name)
+	if err != nil || i < 1 || i > n {
+		return 0, fmt.Errorf("unknown column %q", name)
+	}
+
+	return i - 1, nil
+}
+
+func (b *batchConverter) reportf(name string, line int, format string, args ...any) {
+	b.malformed++
+	fmt.Fprintf(b.stderr, "%s:%d: %s\n", name, line, fmt.Sprintf(format, args...))
+}
+
+// formatFixed renders v / 10^decimals. If precision isn't negative the
+// result is rounded half away from zero to exactly precision decimal places,
+// otherwise it is exact.
+func formatFixed(v *big.Int, decimals, precision int) string {
+	if precision < 0 {
+		return ethunits.FormatUnits(v, decimals)
+	}
+
+	scaled := new(big.Int).Abs(v)
+	if precision >= decimals {
+		scaled.Mul(scaled, pow10(precision-decimals))
+	} else {
+		unit := pow10(decimals - precision)
+		q, r := new(big.Int).QuoRem(scaled, unit, new(big.Int))
+		if r.Lsh(r, 1).Cmp(unit) >= 0 {
+			q.Add(q, big.NewInt(1))
+		}
+		scaled = q
+	}
+
+	digits := scaled.String()
+	if precision > 0 {
+		if len(digits) <= precision {
+			digits = strings.Repeat("0", precision-len(digits)+1) + digits
+		}
+		digits = digits[:len(digits)-precision] + "." + digits[len(digits)-precision:]
+	}
+
+	if v.Sign() < 0 && scaled.Sign() != 0 {
+		digits = "-" + digits
+	}
+
+	return digits
+}
+
+func pow10(exp int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exp)), nil)
+}
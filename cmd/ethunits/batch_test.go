@@ -0,0 +1,99 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunBatch(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		stdin      string
+		want       string
+		wantStderr string
+		wantCode   int
+	}{
+		{
+			name:  "wei to ether",
+			args:  []string{"-columns", "balance"},
+			stdin: "address,balance,note\n0x01,342500000000000000000,\"a, b\"\n0x02,1,c\n",
+			want:  "address,balance,note\n0x01,342.5,\"a, b\"\n0x02,0.000000000000000001,c\n",
+		},
+		{
+			name:  "fixed precision",
+			args:  []string{"-columns", "balance,fee", "-precision", "4"},
+			stdin: "balance,fee\n342500000000000000000,21000000000000\n-15000000000000,49999999999999\n",
+			want:  "balance,fee\n342.5000,0.0000\n0.0000,0.0000\n",
+		},
+		{
+			name:  "rounds half away from zero",
+			args:  []string{"-columns", "1", "-precision", "2", "-from", "gwei", "-to", "ether", "-no-header"},
+			stdin: "5000000\n4999999\n-5000000\n-4999999\n",
+			want:  "0.01\n0.00\n-0.01\n0.00\n",
+		},
+		{
+			name:  "negative tie",
+			args:  []string{"-columns", "1", "-precision", "0", "-from", "wei", "-to", "kwei", "-no-header"},
+			stdin: "-500\n-1500\n-499\n",
+			want:  "-1\n-2\n0\n",
+		},
+		{
+			name:  "between units",
+			args:  []string{"-columns", "price", "-from", "gwei", "-to", "wei"},
+			stdin: "price\n1.5\n30 szabo\n",
+			want:  "price\n1500000000\n30000000000000\n",
+		},
+		{
+			name:       "malformed rows",
+			args:       []string{"-columns", "balance"},
+			stdin:      "address,balance\n0x01,100\n0x02,abc\n0x03\n0x04,1.5\n0x05,\"x\"y\"\n0x06,200\n",
+			want:       "address,balance\n0x01,0.0000000000000001\n0x06,0.0000000000000002\n",
+			wantStderr: "<stdin>:3: column 2: invalid amount \"abc\"\n<stdin>:4: wrong number of fields\n<stdin>:5: column 2: invalid amount \"1.5\"\n<stdin>:6: extraneous or missing \" in quoted-field\nethunits: skipped 4 malformed rows\n",
+			wantCode:   1,
+		},
+		{
+			name:       "decimals column",
+			args:       []string{"-tsv", "-columns", "amount", "-decimals-column", "decimals", "-precision", "2", "testdata/transfers.tsv"},
+			want:       "address\tamount\tdecimals\n0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48\t1234.57\t6\n0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2\t1.50\t18\n",
+			wantStderr: "testdata/transfers.tsv:4: column 3: invalid decimals \"x\"\nethunits: skipped 1 malformed rows\n",
+			wantCode:   1,
+		},
+		{
+			name: "multiple files",
+			args: []string{"-columns", "balance", "testdata/balances1.csv", "testdata/balances2.csv"},
+			want: "address,balance\n0x01,1\n0x02,2.5\n",
+		},
+		{
+			name:       "mismatched headers",
+			args:       []string{"-columns", "balance", "testdata/balances1.csv", "testdata/reordered.csv"},
+			want:       "address,balance\n0x01,1\n",
+			wantStderr: "ethunits: testdata/reordered.csv: header doesn't match the header of the first input\n",
+			wantCode:   1,
+		},
+		{
+			name:       "malformed header",
+			args:       []string{"-columns", "balance"},
+			stdin:      "address,\"bal\"ance\"\n0x01,100\n",
+			wantStderr: "ethunits: <stdin>:1: invalid header: extraneous or missing \" in quoted-field\n",
+			wantCode:   1,
+		},
+		{name: "unknown column", args: []string{"-columns", "nope"}, stdin: "a,b\n1,2\n", want: "", wantStderr: "ethunits: <stdin>: unknown column \"nope\"\n", wantCode: 1},
+		{name: "missing columns", args: nil, stdin: "a\n", wantStderr: "ethunits: -columns is required\n", wantCode: 2},
+		{name: "unknown unit", args: []string{"-columns", "a", "-to", "btc"}, wantStderr: "ethunits: unknown unit \"btc\"\n", wantCode: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+
+			code := run(append([]string{"batch"}, tt.args...), strings.NewReader(tt.stdin), &stdout, &stderr)
+			assert.Equal(t, tt.wantCode, code)
+			assert.Equal(t, tt.want, stdout.String())
+			assert.Equal(t, tt.wantStderr, stderr.String())
+		})
+	}
+}
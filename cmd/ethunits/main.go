@@ -0,0 +1,251 @@
+// Command ethunits converts amounts between Ethereum units.
+//
+// Usage:
+//
+//	ethunits [flags] <amount> [unit] [target unit]
+//	ethunits batch [flags] [file ...]
+//	ethunits repl [-q]
+//
+// For example:
+//
+//	ethunits 1.5 ether gwei
+//	ethunits 0.042eth wei
+//	ethunits 0x4a817c800
+//
+// Hexadecimal amounts are amounts of wei, and decimal amounts without a unit
+// are amounts of the unit given by -u. Without a target unit, the amount is
+// printed in every unit.
+//
+// The batch subcommand streams CSV or TSV rows from the given files, or from
+// standard input, converting the amounts in the selected columns:
+//
+//	ethunits batch -columns balance -precision 6 < balances.csv
+//	ethunits batch -tsv -columns amount -decimals-column decimals transfers.tsv
+//
+// Malformed rows are reported on standard error with their line number and
+// left out of the output. The header row is written once; every file must
+// have the same header as the first.
+//
+// The repl subcommand evaluates unit-aware expressions, as implemented by
+// package calc, one per line:
+//
+//	> gas = 21000
+//	21000
+//	> gas * 35 gwei in ether
+//	0.000735 ether
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"unicode"
+
+	"github.com/jalavosus/go-ethunits"
+)
+
+const (
+	formatDecimal = "decimal"
+	formatHex     = "hex"
+	formatJSON    = "json"
+)
+
+var errUsage = errors.New("usage: ethunits [flags] <amount> [unit] [target unit]")
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) > 0 {
+		switch args[0] {
+		case "batch":
+			return runBatch(args[1:], stdin, stdout, stderr)
+		case "repl":
+			return runRepl(args[1:], stdin, stdout, stderr)
+		}
+	}
+
+	fs := flag.NewFlagSet("ethunits", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	var (
+		format      = fs.String("o", formatDecimal, "output `format`: decimal, hex or json")
+		defaultUnit = fs.String("u", "ether", "`unit` of decimal amounts given without one")
+	)
+
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, errUsage)
+		fs.PrintDefaults()
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	if err := convert(stdout, fs.Args(), *format, *defaultUnit); err != nil {
+		fmt.Fprintln(stderr, "ethunits:", err)
+		if errors.Is(err, errUsage) {
+			return 2
+		}
+		return 1
+	}
+
+	return 0
+}
+
+func convert(w io.Writer, args []string, format, defaultUnit string) error {
+	switch format {
+	case formatDecimal, formatHex, formatJSON:
+	default:
+		return fmt.Errorf("unknown output format %q", format)
+	}
+
+	u, ok := ethunits.ParseUnit(defaultUnit)
+	if !ok {
+		return fmt.Errorf("unknown unit %q", defaultUnit)
+	}
+
+	amount, target, err := splitArgs(args)
+	if err != nil {
+		return err
+	}
+
+	parsed, err := ethunits.ParseAmountIn(amount, u)
+	if err != nil {
+		return err
+	}
+	wei := parsed.Wei
+
+	if target == "" {
+		return printTable(w, wei, format)
+	}
+
+	to, ok := ethunits.ParseUnit(target)
+	if !ok {
+		return fmt.Errorf("unknown unit %q", target)
+	}
+
+	return printValue(w, wei, to, format)
+}
+
+// splitArgs splits the arguments into the amount, with its unit if any,
+// and the target unit. A second argument is the amount's unit unless the
+// first argument already carries one or is hexadecimal.
+func splitArgs(args []string) (amount, target string, err error) {
+	switch len(args) {
+	case 1:
+		return args[0], "", nil
+	case 2:
+		if hasUnit(args[0]) {
+			return args[0], args[1], nil
+		}
+		return args[0] + " " + args[1], "", nil
+	case 3:
+		return args[0] + " " + args[1], args[2], nil
+	}
+
+	return "", "", errUsage
+}
+
+func hasUnit(amount string) bool {
+	if strings.HasPrefix(amount, "0x") || strings.HasPrefix(amount, "0X") {
+		return true
+	}
+
+	return amount != "" && unicode.IsLetter(rune(amount[len(amount)-1]))
+}
+
+type jsonValue struct {
+	Unit  string `json:"unit"`
+	Value string `json:"value"`
+}
+
+type jsonOutput struct {
+	Wei string `json:"wei"`
+	jsonValue
+}
+
+type jsonTable struct {
+	Wei    string      `json:"wei"`
+	Values []jsonValue `json:"values"`
+}
+
+func printValue(w io.Writer, wei *big.Int, u ethunits.Unit, format string) error {
+	v, err := formatValue(wei, u, format)
+	if err != nil {
+		return err
+	}
+
+	if format == formatJSON {
+		return writeJSON(w, jsonOutput{Wei: wei.String(), jsonValue: jsonValue{Unit: unitName(u), Value: v}})
+	}
+
+	_, err = fmt.Fprintln(w, v)
+	return err
+}
+
+func printTable(w io.Writer, wei *big.Int, format string) error {
+	units := ethunits.Units()
+
+	if format == formatJSON {
+		out := jsonTable{Wei: wei.String(), Values: make([]jsonValue, 0, len(units))}
+		for _, u := range units {
+			v, _ := formatValue(wei, u, formatDecimal)
+			out.Values = append(out.Values, jsonValue{Unit: unitName(u), Value: v})
+		}
+
+		return writeJSON(w, out)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, u := range units {
+		v, err := formatValue(wei, u, format)
+		if err != nil {
+			// Fractional amounts have no hexadecimal representation.
+			v = "-"
+		}
+
+		fmt.Fprintf(tw, "%s\t%s\n", unitName(u), v)
+	}
+
+	return tw.Flush()
+}
+
+// formatValue renders an amount of wei in u. JSON values are decimal strings.
+func formatValue(wei *big.Int, u ethunits.Unit, format string) (string, error) {
+	exp, _ := u.WeiExponent()
+
+	if format != formatHex {
+		s, _ := ethunits.FormatWei(wei, u)
+		return s, nil
+	}
+
+	q, r := new(big.Int).QuoRem(wei, pow10(exp), new(big.Int))
+	if r.Sign() != 0 {
+		return "", fmt.Errorf("amount is not a whole number of %s", unitName(u))
+	}
+
+	if q.Sign() < 0 {
+		return "-0x" + new(big.Int).Neg(q).Text(16), nil
+	}
+
+	return "0x" + q.Text(16), nil
+}
+
+func unitName(u ethunits.Unit) string {
+	return strings.ToLower(u.String())
+}
+
+func writeJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(v)
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		want     string
+		wantErr  string
+		wantCode int
+	}{
+		{name: "ether to gwei", args: []string{"1.5", "ether", "gwei"}, want: "1500000000\n"},
+		{name: "unit suffix", args: []string{"0.042eth", "wei"}, want: "42000000000000000\n"},
+		{
+			name: "default unit",
+			args: []string{"-u", "gwei", "2.5"},
+			want: "wei     2500000000\n" +
+				"kwei    2500000\n" +
+				"mwei    2500\n" +
+				"gwei    2.5\n" +
+				"szabo   0.0025\n" +
+				"finney  0.0000025\n" +
+				"ether   0.0000000025\n",
+		},
+		{name: "hex input", args: []string{"0x4a817c800", "gwei"}, want: "20\n"},
+		{name: "hex output", args: []string{"-o", "hex", "20", "gwei", "wei"}, want: "0x4a817c800\n"},
+		{
+			name: "json output",
+			args: []string{"-o", "json", "1.5", "gwei", "wei"},
+			want: "{\n  \"wei\": \"1500000000\",\n  \"unit\": \"wei\",\n  \"value\": \"1500000000\"\n}\n",
+		},
+		{
+			name: "table",
+			args: []string{"0x4a817c800"},
+			want: "wei     20000000000\n" +
+				"kwei    20000000\n" +
+				"mwei    20000\n" +
+				"gwei    20\n" +
+				"szabo   0.02\n" +
+				"finney  0.00002\n" +
+				"ether   0.00000002\n",
+		},
+		{
+			name: "hex table",
+			args: []string{"-o", "hex", "1", "szabo"},
+			want: "wei     0xe8d4a51000\n" +
+				"kwei    0x3b9aca00\n" +
+				"mwei    0xf4240\n" +
+				"gwei    0x3e8\n" +
+				"szabo   0x1\n" +
+				"finney  -\n" +
+				"ether   -\n",
+		},
+		{
+			name: "json table",
+			args: []string{"-o", "json", "1", "finney"},
+			want: `{
+  "wei": "1000000000000000",
+  "values": [
+    {
+      "unit": "wei",
+      "value": "1000000000000000"
+    },
+    {
+      "unit": "kwei",
+      "value": "1000000000000"
+    },
+    {
+      "unit": "mwei",
+      "value": "1000000000"
+    },
+    {
+      "unit": "gwei",
+      "value": "1000000"
+    },
+    {
+      "unit": "szabo",
+      "value": "1000"
+    },
+    {
+      "unit": "finney",
+      "value": "1"
+    },
+    {
+      "unit": "ether",
+      "value": "0.001"
+    }
+  ]
+}
+`,
+		},
+		{name: "fractional hex", args: []string{"-o", "hex", "1", "wei", "gwei"}, wantCode: 1},
+		{name: "fractional wei", args: []string{"0.5", "wei"}, wantErr: "ethunits: invalid amount \"0.5 wei\": not a whole number of wei\n", wantCode: 1},
+		{name: "bad hex", args: []string{"0xzz"}, wantErr: "ethunits: invalid amount \"0xzz\": want a hexadecimal number of wei, such as \"0x4a817c800\"\n", wantCode: 1},
+		{name: "unknown amount unit", args: []string{"1", "dogecoin"}, wantErr: "ethunits: unknown unit \"dogecoin\" in \"1 dogecoin\"\n", wantCode: 1},
+		{name: "unknown unit", args: []string{"1", "ether", "dogecoin"}, wantCode: 1},
+		{name: "unknown format", args: []string{"-o", "yaml", "1"}, wantCode: 1},
+		{name: "no arguments", args: nil, wantCode: 2},
+		{name: "too many arguments", args: []string{"1", "ether", "gwei", "wei"}, wantCode: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+
+			code := run(tt.args, nil, &stdout, &stderr)
+			assert.Equal(t, tt.wantCode, code, stderr.String())
+			assert.Equal(t, tt.want, stdout.String())
+			if tt.wantErr != "" {
+				assert.Equal(t, tt.wantErr, stderr.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/jalavosus/go-ethunits/calc"
+)
+
+const replPrompt = "> "
+
+// runRepl evaluates the calculator expressions read from stdin, one per line,
+// until "exit", "quit" or the end of the input. The result of the last
+// expression is kept in the variable "ans".
+func runRepl(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("ethunits repl", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	quiet := fs.Bool("q", false, "don't print a prompt")
+
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	var (
+		c       calc.Calculator
+		scanner = bufio.NewScanner(stdin)
+	)
+
+	for {
+		if !*quiet {
+			fmt.Fprint(stdout, replPrompt)
+		}
+
+		if !scanner.Scan() {
+			break
+		}
+
+		line := strings.TrimSpace(scanner.Text())
+		switch line {
+		case "":
+			continue
+		case "exit", "quit":
+			return 0
+		}
+
+		v, err := c.Eval(line)
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			continue
+		}
+
+		c.Set("ans", v)
+		fmt.Fprintln(stdout, v)
+	}
+
+	if !*quiet {
+		fmt.Fprintln(stdout)
+	}
+
+	if err := scanner.Err(); err != nil {
+		fmt.Fprintln(stderr, "ethunits:", err)
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunRepl(t *testing.T) {
+	input := strings.Join([]string{
+		"gas = 21000",
+		"",
+		"gas * 35 gwei in ether",
+		"ans * 2",
+		"1 ether + 1",
+		"quit",
+		"1 + 1",
+	}, "\n")
+
+	var stdout, stderr bytes.Buffer
+
+	code := run([]string{"repl", "-q"}, strings.NewReader(input), &stdout, &stderr)
+	assert.Equal(t, 0, code)
+	assert.Equal(t, "21000\n0.000735 ether\n0.00147 ether\n", stdout.String())
+	assert.Equal(t, "calc: column 9: incompatible dimensions: can't add a number to an amount\n", stderr.String())
+
+	stdout.Reset()
+	stderr.Reset()
+
+	code = run([]string{"repl"}, strings.NewReader("1 ether in gwei\n"), &stdout, &stderr)
+	assert.Equal(t, 0, code)
+	assert.Equal(t, "> 1000000000 gwei\n> \n", stdout.String())
+}
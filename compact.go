@@ -0,0 +1,252 @@
+package ethunits
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Defaults used by CompactOptions fields left at their zero value.
+const (
+	DefaultSignificantDigits = 3
+	DefaultCompactThreshold  = 1000
+	DefaultSubscriptZeros    = 4
+)
+
+var compactSuffixes = []string{"", "K", "M", "B", "T"}
+
+var subscriptDigits = []rune("₀₁₂₃₄₅₆₇₈₉")
+
+// CompactOptions configures compact formatting of amounts, such as
+// "1.23M", "4.5K" or "0.0₅123". The zero value uses the defaults.
+type CompactOptions struct {
+	// SignificantDigits is the number of significant digits shown.
+	// Amounts of at least 1 without a suffix keep all their integer digits.
+	SignificantDigits int
+	// Threshold is the smallest absolute amount written with a K, M, B or T
+	// suffix.
+	Threshold uint64
+	// MinValue, if set, is the smallest non-zero absolute amount shown.
+	// Smaller amounts are written as "<MinValue", e.g. "<0.0001".
+	MinValue *big.Rat
+	// Subscript writes amounts below 1 with at least SubscriptZeros zeros
+	// after the decimal point in subscript-zero notation, e.g. 0.00000123
+	// as "0.0₅123". It is ignored for amounts below MinValue.
+	Subscript      bool
+	SubscriptZeros int
+}
+
+func (o *CompactOptions) withDefaults() CompactOptions {
+	var opts CompactOptions
+	if o != nil {
+		opts = *o
+	}
+
+	if opts.SignificantDigits <= 0 {
+		opts.SignificantDigits = DefaultSignificantDigits
+	}
+	if opts.Threshold == 0 {
+		opts.Threshold = DefaultCompactThreshold
+	}
+	if opts.SubscriptZeros <= 0 {
+		opts.SubscriptZeros = DefaultSubscriptZeros
+	}
+
+	return opts
+}
+
+// Format renders an exact amount in compact notation.
+func (o *CompactOptions) Format(amount *big.Rat) string {
+	opts := o.withDefaults()
+
+	abs := new(big.Rat).Abs(amount)
+
+	sign := ""
+	if amount.Sign() < 0 {
+		sign = "-"
+	}
+
+	threshold := new(big.Rat).SetInt(new(big.Int).SetUint64(opts.Threshold))
+
+	switch {
+	case abs.Sign() == 0:
+		return "0"
+	case abs.Cmp(threshold) >= 0:
+		return sign + formatSuffixed(abs, opts.SignificantDigits)
+	case abs.Cmp(big.NewRat(1, 1)) >= 0:
+		sig := len(new(big.Int).Quo(abs.Num(), abs.Denom()).String())
+		if sig < opts.SignificantDigits {
+			sig = opts.SignificantDigits
+		}
+
+		n, exp := roundSignificant(abs, sig)
+
+		// Amounts which round up to the threshold are written with a suffix.
+		if rounded := significantRat(n, exp); rounded.Cmp(threshold) >= 0 {
+			return sign + formatSuffixed(rounded, opts.SignificantDigits)
+		}
+
+		return sign + formatSignificant(n, exp)
+	case opts.MinValue != nil && abs.Cmp(opts.MinValue) < 0:
+		if sign != "" {
+			return ">-" + formatRatExact(opts.MinValue)
+		}
+		return "<" + formatRatExact(opts.MinValue)
+	}
+
+	n, exp := roundSignificant(abs, opts.SignificantDigits)
+
+	// The number of zeros between the decimal point and the first
+	// significant digit.
+	zeros := -exp - 1
+	if opts.Subscript && zeros >= opts.SubscriptZeros {
+		return sign + "0.0" + subscript(zeros) + strings.TrimRight(n.String(), "0")
+	}
+
+	return sign + formatSignificant(n, exp)
+}
+
+// FormatCompact renders an amount of Wei in u in compact notation, followed
+// by the unit's symbol, e.g. "1.23M ETH" or "4.5K gwei". A nil opts uses the
+// defaults. It returns false if u is unknown.
+func FormatCompact(wei *big.Int, u Unit, opts *CompactOptions) (string, bool) {
+	exp, ok := u.WeiExponent()
+	if !ok {
+		return "", false
+	}
+
+	return FormatCompactUnits(wei, exp, opts) + " " + unitSymbol(u), true
+}
+
+// FormatCompactUnits renders a raw amount of a currency with the given
+// number of decimals in compact notation. A nil opts uses the defaults.
+func FormatCompactUnits[T DecimalValue](amount *big.Int, decimals T, opts *CompactOptions) string {
+	return opts.Format(new(big.Rat).SetFrac(amount, pow10Int(int(decimals))))
+}
+
+// formatSuffixed renders a positive amount with the largest suffix that
+// keeps it at least 1, moving to the next suffix if rounding reaches 1000.
+func formatSuffixed(abs *big.Rat, sig int) string {
+	i := (len(new(big.Int).Quo(abs.Num(), abs.Denom()).String()) - 1) / 3
+	if i > len(compactSuffixes)-1 {
+		i = len(compactSuffixes) - 1
+	}
+
+	for {
+		scaled := new(big.Rat).Quo(abs, new(big.Rat).SetInt(pow10Int(3*i)))
+		n, exp := roundSignificant(scaled, sig)
+
+		if exp >= 3 && i < len(compactSuffixes)-1 {
+			i++
+			continue
+		}
+
+		if exp >= sig-1 {
+			// Keep every integer digit of amounts beyond the largest suffix.
+			n, exp = roundSignificant(scaled, exp+1)
+		}
+
+		return formatSignificant(n, exp) + compactSuffixes[i]
+	}
+}
+
+// roundSignificant rounds a positive amount half up to sig significant
+// digits, returning them as an integer n of sig digits and the decimal
+// exponent of its first digit: the rounded amount is n * 10^(exp-sig+1).
+func roundSignificant(abs *big.Rat, sig int) (*big.Int, int) {
+	exp := decimalExponent(abs)
+
+	for {
+		shift := sig - 1 - exp
+
+		scaled := new(big.Rat).Set(abs)
+		if shift >= 0 {
+			scaled.Mul(scaled, new(big.Rat).SetInt(pow10Int(shift)))
+		} else {
+			scaled.Quo(scaled, new(big.Rat).SetInt(pow10Int(-shift)))
+		}
+
+		n, r := new(big.Int).QuoRem(scaled.Num(), scaled.Denom(), new(big.Int))
+		if r.Lsh(r, 1).Cmp(scaled.Denom()) >= 0 {
+			n.Add(n, big.NewInt(1))
+		}
+
+		// Rounding up 9.99… gains a digit.
+		if len(n.String()) > sig {
+			exp++
+			continue
+		}
+
+		return n, exp
+	}
+}
+
+// decimalExponent returns floor(log10(abs)) for a positive amount.
+func decimalExponent(abs *big.Rat) int {
+	intPart := new(big.Int).Quo(abs.Num(), abs.Denom())
+	if intPart.Sign() > 0 {
+		return len(intPart.String()) - 1
+	}
+
+	exp := 0
+	for r := new(big.Rat).Set(abs); r.Cmp(big.NewRat(1, 1)) < 0; exp-- {
+		r.Mul(r, big.NewRat(10, 1))
+	}
+
+	return exp
+}
+
+// significantRat returns n * 10^(exp-len(n)+1).
+func significantRat(n *big.Int, exp int) *big.Rat {
+	shift := exp - len(n.String()) + 1
+	if shift >= 0 {
+		return new(big.Rat).SetInt(new(big.Int).Mul(n, pow10Int(shift)))
+	}
+
+	return new(big.Rat).SetFrac(n, pow10Int(-shift))
+}
+
+// formatSignificant renders n * 10^(exp-len(n)+1) as a decimal string
+// without trailing zeros.
+func formatSignificant(n *big.Int, exp int) string {
+	decimals := len(n.String()) - 1 - exp
+	if decimals < 0 {
+		return new(big.Int).Mul(n, pow10Int(-decimals)).String()
+	}
+
+	return FormatUnits(n, decimals)
+}
+
+func formatRatExact(r *big.Rat) string {
+	if s := r.FloatString(0); r.IsInt() {
+		return s
+	}
+
+	for d := 1; d <= 255; d++ {
+		scaled := new(big.Rat).Mul(r, new(big.Rat).SetInt(pow10Int(d)))
+		if scaled.IsInt() {
+			return FormatUnits(scaled.Num(), d)
+		}
+	}
+
+	return r.FloatString(18)
+}
+
+func subscript(n int) string {
+	var b strings.Builder
+	for _, c := range strconv.Itoa(n) {
+		b.WriteRune(subscriptDigits[c-'0'])
+	}
+
+	return b.String()
+}
+
+// unitSymbol returns the symbol amounts of u are written with,
+// "ETH" for Ether and the unit's name otherwise.
+func unitSymbol(u Unit) string {
+	if u == Ether {
+		return "ETH"
+	}
+
+	return unitName(u)
+}
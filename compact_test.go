@@ -0,0 +1,97 @@
+package ethunits_test
+
+import (
+	"flag"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jalavosus/go-ethunits"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+var compactOptions = []struct {
+	name string
+	opts *ethunits.CompactOptions
+}{
+	{name: "default", opts: nil},
+	{name: "sig=5", opts: &ethunits.CompactOptions{SignificantDigits: 5}},
+	{name: "threshold=1M", opts: &ethunits.CompactOptions{Threshold: 1_000_000}},
+	{name: "min=0.0001", opts: &ethunits.CompactOptions{MinValue: big.NewRat(1, 10000)}},
+	{name: "subscript", opts: &ethunits.CompactOptions{Subscript: true}},
+}
+
+func TestFormatCompactGolden(t *testing.T) {
+	amounts := []struct {
+		wei  string
+		unit ethunits.Unit
+	}{
+		{wei: "0", unit: ethunits.Ether},
+		{wei: "1", unit: ethunits.Ether},
+		{wei: "1", unit: ethunits.Wei},
+		{wei: "999", unit: ethunits.Wei},
+		{wei: "1000", unit: ethunits.Wei},
+		{wei: "4500000000000", unit: ethunits.GWei},
+		{wei: "99999000000000", unit: ethunits.GWei},
+		{wei: "123000000000000", unit: ethunits.Szabo},
+		{wei: "99999999999999", unit: ethunits.Ether},
+		{wei: "100000000000000", unit: ethunits.Ether},
+		{wei: "1230000000000", unit: ethunits.Ether},
+		{wei: "123400000000000", unit: ethunits.Ether},
+		{wei: "-1230000000000", unit: ethunits.Ether},
+		{wei: "12345000000000000", unit: ethunits.Ether},
+		{wei: "99999999999999999", unit: ethunits.Ether},
+		{wei: "100000000000000000", unit: ethunits.Ether},
+		{wei: "123456789000000000", unit: ethunits.Ether},
+		{wei: "999499999999999999", unit: ethunits.Ether},
+		{wei: "999500000000000000", unit: ethunits.Ether},
+		{wei: "1000000000000000000", unit: ethunits.Ether},
+		{wei: "1234567890000000000", unit: ethunits.Ether},
+		{wei: "-1234567890000000000", unit: ethunits.Ether},
+		{wei: wantWeiStr, unit: ethunits.Ether},
+		{wei: "999999000000000000000", unit: ethunits.Ether},
+		{wei: "999999999000000000000", unit: ethunits.Ether},
+		{wei: "1000000000000000000000", unit: ethunits.Ether},
+		{wei: "12345678000000000000000", unit: ethunits.Ether},
+		{wei: "999950000000000000000000", unit: ethunits.Ether},
+		{wei: "1230000000000000000000000", unit: ethunits.Ether},
+		{wei: "-1230000000000000000000000", unit: ethunits.Ether},
+		{wei: "4560000000000000000000000000", unit: ethunits.Ether},
+		{wei: "7890000000000000000000000000000", unit: ethunits.Ether},
+		{wei: "999999999999999999999999999999999", unit: ethunits.Ether},
+		{wei: "12345678900000000000000000000000000", unit: ethunits.Ether},
+	}
+
+	var b strings.Builder
+	for _, a := range amounts {
+		for _, o := range compactOptions {
+			got, ok := ethunits.FormatCompact(makeBigInt(a.wei), a.unit, o.opts)
+			require.True(t, ok)
+			fmt.Fprintf(&b, "%s %s\t%s\t%s\n", a.wei, strings.ToLower(a.unit.String()), o.name, got)
+		}
+	}
+
+	const golden = "testdata/compact.golden"
+	if *update {
+		require.NoError(t, os.WriteFile(golden, []byte(b.String()), 0o644))
+	}
+
+	want, err := os.ReadFile(golden)
+	require.NoError(t, err)
+	assert.Equal(t, string(want), b.String())
+}
+
+func TestFormatCompactUnits(t *testing.T) {
+	assert.Equal(t, "1.23M", ethunits.FormatCompactUnits(big.NewInt(1_234_567_891_234), 6, nil))
+	assert.Equal(t, "0.0₁₂1", ethunits.FormatCompactUnits(big.NewInt(1), 13, &ethunits.CompactOptions{Subscript: true}))
+	assert.Equal(t, "<0.00001", ethunits.FormatCompactUnits(big.NewInt(1), 6, &ethunits.CompactOptions{MinValue: big.NewRat(1, 100000)}))
+
+	_, ok := ethunits.FormatCompact(big.NewInt(1), ethunits.Unknown, nil)
+	assert.False(t, ok)
+}
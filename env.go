@@ -0,0 +1,41 @@
+package ethunits
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// AmountFromEnv reads an amount, such as "150 gwei" or a plain number of
+// defaultUnit, from the environment variable with the given name.
+// It returns false if the variable is unset or empty, and an error naming
+// the variable if it can't be parsed.
+func AmountFromEnv(name string, defaultUnit Unit) (*Amount, bool, error) {
+	s, ok := os.LookupEnv(name)
+	if !ok || strings.TrimSpace(s) == "" {
+		return nil, false, nil
+	}
+
+	a, err := ParseAmountIn(s, defaultUnit)
+	if err != nil {
+		return nil, false, fmt.Errorf("$%s: %w", name, err)
+	}
+
+	return a, true, nil
+}
+
+// UnitFromEnv reads a unit name from the environment variable with the given name.
+// It returns false if the variable is unset or empty.
+func UnitFromEnv(name string) (Unit, bool, error) {
+	s, ok := os.LookupEnv(name)
+	if !ok || strings.TrimSpace(s) == "" {
+		return Unknown, false, nil
+	}
+
+	var u Unit
+	if err := u.Set(s); err != nil {
+		return Unknown, false, fmt.Errorf("$%s: %w", name, err)
+	}
+
+	return u, true, nil
+}
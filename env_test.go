@@ -0,0 +1,45 @@
+package ethunits_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jalavosus/go-ethunits"
+)
+
+func TestAmountFromEnv(t *testing.T) {
+	t.Setenv("MAX_GAS_PRICE", "150 gwei")
+	t.Setenv("MIN_BALANCE", "500000000000000000")
+	t.Setenv("EMPTY", "")
+	t.Setenv("BAD", "150 dogecoin")
+	t.Setenv("DISPLAY_UNIT", "finney")
+
+	a, ok, err := ethunits.AmountFromEnv("MAX_GAS_PRICE", ethunits.Ether)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "0.00000015 ether", a.String())
+
+	a, ok, err = ethunits.AmountFromEnv("MIN_BALANCE", ethunits.Wei)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assertBigIntEqual(t, makeBigInt("500000000000000000"), a.Wei)
+
+	for _, name := range []string{"EMPTY", "UNSET_AMOUNT_VARIABLE"} {
+		_, ok, err = ethunits.AmountFromEnv(name, ethunits.Ether)
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	}
+
+	_, _, err = ethunits.AmountFromEnv("BAD", ethunits.Ether)
+	assert.EqualError(t, err, `$BAD: unknown unit "dogecoin" in "150 dogecoin"`)
+
+	u, ok, err := ethunits.UnitFromEnv("DISPLAY_UNIT")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, ethunits.Finney, u)
+
+	_, _, err = ethunits.UnitFromEnv("BAD")
+	assert.ErrorIs(t, err, ethunits.ErrUnknownUnit)
+}
@@ -0,0 +1,86 @@
+// Package erc4626 reproduces the share and asset conversions of
+// OpenZeppelin's ERC4626 tokenized vault implementation off-chain.
+package erc4626
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/jalavosus/go-ethunits"
+)
+
+// ErrDecimalsOverflow is returned when the share decimals don't fit in a
+// uint8, where the vault's decimals() would revert.
+var ErrDecimalsOverflow = errors.New("erc4626: share decimals overflow")
+
+// Vault is a snapshot of the state of an ERC-4626 vault.
+// TotalAssets and TotalSupply are raw amounts, as returned by the vault's
+// totalAssets() and totalSupply() functions.
+type Vault struct {
+	TotalAssets *big.Int
+	TotalSupply *big.Int
+	// DecimalsOffset is the value returned by the vault's _decimalsOffset(),
+	// which is 0 unless the vault overrides it.
+	DecimalsOffset uint8
+}
+
+// ShareDecimals returns the decimals of the vault's shares,
+// given the decimals of its underlying asset.
+func (v Vault) ShareDecimals(assetDecimals uint8) (uint8, error) {
+	if assetDecimals > 255-v.DecimalsOffset {
+		return 0, ErrDecimalsOverflow
+	}
+
+	return assetDecimals + v.DecimalsOffset, nil
+}
+
+// ConvertToShares returns the amount of shares exchanged for the given amount
+// of assets, rounded in the given direction.
+func (v Vault) ConvertToShares(assets *big.Int, rounding ethunits.Rounding) (*big.Int, error) {
+	return ethunits.MulDiv(assets, v.virtualSupply(), v.virtualAssets(), rounding)
+}
+
+// ConvertToAssets returns the amount of assets exchanged for the given amount
+// of shares, rounded in the given direction.
+func (v Vault) ConvertToAssets(shares *big.Int, rounding ethunits.Rounding) (*big.Int, error) {
+	return ethunits.MulDiv(shares, v.virtualAssets(), v.virtualSupply(), rounding)
+}
+
+// PreviewDeposit returns the shares minted by depositing the given assets.
+func (v Vault) PreviewDeposit(assets *big.Int) (*big.Int, error) {
+	return v.ConvertToShares(assets, ethunits.RoundFloor)
+}
+
+// PreviewMint returns the assets required to mint the given shares.
+func (v Vault) PreviewMint(shares *big.Int) (*big.Int, error) {
+	return v.ConvertToAssets(shares, ethunits.RoundCeil)
+}
+
+// PreviewWithdraw returns the shares burned by withdrawing the given assets.
+func (v Vault) PreviewWithdraw(assets *big.Int) (*big.Int, error) {
+	return v.ConvertToShares(assets, ethunits.RoundCeil)
+}
+
+// PreviewRedeem returns the assets received by redeeming the given shares.
+func (v Vault) PreviewRedeem(shares *big.Int) (*big.Int, error) {
+	return v.ConvertToAssets(shares, ethunits.RoundFloor)
+}
+
+// virtualSupply returns totalSupply() + 10 ** _decimalsOffset().
+func (v Vault) virtualSupply() *big.Int {
+	offset := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(v.DecimalsOffset)), nil)
+	return offset.Add(offset, orZero(v.TotalSupply))
+}
+
+// virtualAssets returns totalAssets() + 1.
+func (v Vault) virtualAssets() *big.Int {
+	return new(big.Int).Add(orZero(v.TotalAssets), big.NewInt(1))
+}
+
+func orZero(v *big.Int) *big.Int {
+	if v == nil {
+		return new(big.Int)
+	}
+
+	return v
+}
@@ -0,0 +1,80 @@
+package erc4626_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jalavosus/go-ethunits"
+	"github.com/jalavosus/go-ethunits/erc4626"
+)
+
+func TestVault_Preview(t *testing.T) {
+	tests := []struct {
+		name    string
+		vault   erc4626.Vault
+		preview func(erc4626.Vault, *big.Int) (*big.Int, error)
+		amount  int64
+		want    int64
+	}{
+		{name: "deposit", vault: erc4626.Vault{TotalAssets: big.NewInt(1000), TotalSupply: big.NewInt(900)}, preview: erc4626.Vault.PreviewDeposit, amount: 100, want: 90},
+		{name: "withdraw", vault: erc4626.Vault{TotalAssets: big.NewInt(1000), TotalSupply: big.NewInt(900)}, preview: erc4626.Vault.PreviewWithdraw, amount: 100, want: 91},
+		{name: "redeem", vault: erc4626.Vault{TotalAssets: big.NewInt(1000), TotalSupply: big.NewInt(900)}, preview: erc4626.Vault.PreviewRedeem, amount: 100, want: 111},
+		{name: "mint", vault: erc4626.Vault{TotalAssets: big.NewInt(1000), TotalSupply: big.NewInt(900)}, preview: erc4626.Vault.PreviewMint, amount: 100, want: 112},
+		{name: "empty vault", vault: erc4626.Vault{}, preview: erc4626.Vault.PreviewDeposit, amount: 100, want: 100},
+		{name: "empty vault,offset=3", vault: erc4626.Vault{DecimalsOffset: 3}, preview: erc4626.Vault.PreviewDeposit, amount: 100, want: 100000},
+		{
+			name:    "donation attack,offset=3",
+			vault:   erc4626.Vault{TotalAssets: big.NewInt(1_000_001), TotalSupply: big.NewInt(1000), DecimalsOffset: 3},
+			preview: erc4626.Vault.PreviewDeposit,
+			amount:  1_000_000,
+			want:    1999,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.preview(tt.vault, big.NewInt(tt.amount))
+			require.NoError(t, err)
+			assert.Equal(t, big.NewInt(tt.want), got)
+		})
+	}
+}
+
+func TestVault_Wei(t *testing.T) {
+	totalAssets, ok := ethunits.ToWei("1234.5", ethunits.Ether)
+	require.True(t, ok)
+	totalSupply, ok := ethunits.ToWei("1000", ethunits.Ether)
+	require.True(t, ok)
+
+	vault := erc4626.Vault{TotalAssets: totalAssets, TotalSupply: totalSupply}
+
+	deposit, ok := ethunits.ToWei("1", ethunits.Ether)
+	require.True(t, ok)
+
+	shares, err := vault.PreviewDeposit(deposit)
+	require.NoError(t, err)
+
+	decimals, err := vault.ShareDecimals(18)
+	require.NoError(t, err)
+	assert.Equal(t, "0.810044552450384771", ethunits.FormatUnits(shares, decimals))
+
+	assets, err := vault.PreviewRedeem(shares)
+	require.NoError(t, err)
+	assert.True(t, assets.Cmp(deposit) <= 0, "redeeming must never return more than was deposited")
+}
+
+func TestVault_ShareDecimals(t *testing.T) {
+	decimals, err := erc4626.Vault{DecimalsOffset: 3}.ShareDecimals(6)
+	require.NoError(t, err)
+	assert.Equal(t, uint8(9), decimals)
+
+	decimals, err = erc4626.Vault{DecimalsOffset: 5}.ShareDecimals(250)
+	require.NoError(t, err)
+	assert.Equal(t, uint8(255), decimals)
+
+	_, err = erc4626.Vault{DecimalsOffset: 10}.ShareDecimals(250)
+	assert.ErrorIs(t, err, erc4626.ErrDecimalsOverflow)
+}
@@ -0,0 +1,141 @@
+// Package fiat converts amounts of ether and tokens into fiat currencies,
+// using prices from a pluggable PriceSource.
+package fiat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/jalavosus/go-ethunits"
+)
+
+var (
+	ErrUnknownCurrency = errors.New("fiat: unknown currency")
+	ErrUnknownChain    = errors.New("fiat: unknown chain")
+)
+
+// Amount is an amount of a fiat currency, held in the currency's minor units.
+type Amount struct {
+	Currency string
+	// Minor is the amount in minor units, e.g. cents for USD.
+	Minor *big.Int
+	// Decimals is the number of decimals of the currency's minor unit.
+	Decimals uint8
+	// Timestamp is the time of the quote the amount was converted at.
+	Timestamp time.Time
+}
+
+// Decimal renders the amount as a decimal string with exactly as many
+// decimal places as the currency's minor unit, e.g. "3123.40".
+func (a Amount) Decimal() string {
+	minor := a.Minor
+	if minor == nil {
+		minor = new(big.Int)
+	}
+
+	digits := new(big.Int).Abs(minor).String()
+	if d := int(a.Decimals); d > 0 {
+		if len(digits) <= d {
+			digits = strings.Repeat("0", d-len(digits)+1) + digits
+		}
+		digits = digits[:len(digits)-d] + "." + digits[len(digits)-d:]
+	}
+
+	if minor.Sign() < 0 {
+		digits = "-" + digits
+	}
+
+	return digits
+}
+
+// String renders the amount followed by its currency code, e.g. "3123.40 USD".
+func (a Amount) String() string {
+	return a.Decimal() + " " + a.Currency
+}
+
+// Convert converts a raw amount of an asset with the given decimals into the
+// quote's currency. The result is rounded half away from zero to the
+// currency's minor units.
+func Convert(amount *big.Int, decimals uint8, q Quote) (Amount, error) {
+	minor, ok := MinorUnits(q.Currency)
+	if !ok {
+		return Amount{}, fmt.Errorf("%w: %q", ErrUnknownCurrency, q.Currency)
+	}
+
+	// amount * price * 10^minor / 10^(decimals + q.Decimals)
+	num := new(big.Int).Mul(amount, q.price())
+	num.Mul(num, pow10(int(minor)))
+	den := pow10(int(decimals) + int(q.Decimals))
+
+	return Amount{
+		Currency:  normalize(q.Currency),
+		Minor:     quoHalfUp(num, den),
+		Decimals:  minor,
+		Timestamp: q.Timestamp,
+	}, nil
+}
+
+// Converter converts amounts using quotes from a PriceSource.
+type Converter struct {
+	Source PriceSource
+}
+
+// NewConverter returns a Converter using quotes from src.
+func NewConverter(src PriceSource) *Converter {
+	return &Converter{Source: src}
+}
+
+// Convert converts a raw amount of the asset with the given decimals into currency.
+func (c *Converter) Convert(ctx context.Context, amount *big.Int, decimals uint8, asset, currency string) (Amount, error) {
+	q, err := c.Source.Quote(ctx, asset, currency)
+	if err != nil {
+		return Amount{}, err
+	}
+
+	return Convert(amount, decimals, q)
+}
+
+// ConvertToken converts a raw amount of the token into currency,
+// using the quote for the token's symbol.
+func (c *Converter) ConvertToken(ctx context.Context, raw *big.Int, token ethunits.Token, currency string) (Amount, error) {
+	return c.Convert(ctx, raw, token.Decimals, token.Symbol, currency)
+}
+
+// ConvertWei converts an amount of Wei of the native currency of the chain
+// with the given ID into currency, using the quote for the native currency's symbol.
+func (c *Converter) ConvertWei(ctx context.Context, wei *big.Int, chainID uint64, currency string) (Amount, error) {
+	chain, ok := ethunits.ChainByID(chainID)
+	if !ok {
+		return Amount{}, fmt.Errorf("%w: %d", ErrUnknownChain, chainID)
+	}
+
+	native := chain.NativeCurrency
+
+	return c.Convert(ctx, wei, native.Decimals, native.Symbol, currency)
+}
+
+// quoHalfUp returns num / den rounded half away from zero.
+func quoHalfUp(num, den *big.Int) *big.Int {
+	q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+
+	if new(big.Int).Lsh(r.Abs(r), 1).Cmp(den) >= 0 {
+		if num.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+
+	return q
+}
+
+func pow10(exp int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exp)), nil)
+}
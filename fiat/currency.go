@@ -0,0 +1,66 @@
+package fiat
+
+import (
+	"strings"
+	"sync"
+)
+
+var (
+	currenciesMu sync.RWMutex
+	// minorUnits holds the ISO 4217 minor unit exponent of each currency.
+	minorUnits = map[string]uint8{
+		"AUD": 2,
+		"BHD": 3,
+		"BRL": 2,
+		"CAD": 2,
+		"CHF": 2,
+		"CNY": 2,
+		"CZK": 2,
+		"DKK": 2,
+		"EUR": 2,
+		"GBP": 2,
+		"HKD": 2,
+		"HUF": 2,
+		"IDR": 2,
+		"ILS": 2,
+		"INR": 2,
+		"JPY": 0,
+		"KRW": 0,
+		"KWD": 3,
+		"MXN": 2,
+		"NOK": 2,
+		"NZD": 2,
+		"PLN": 2,
+		"SEK": 2,
+		"SGD": 2,
+		"THB": 2,
+		"TRY": 2,
+		"TWD": 2,
+		"USD": 2,
+		"VND": 0,
+		"ZAR": 2,
+	}
+)
+
+// MinorUnits returns the number of decimal places of the given ISO 4217
+// currency's minor unit, e.g. 2 for USD and 0 for JPY.
+func MinorUnits(currency string) (uint8, bool) {
+	currenciesMu.RLock()
+	defer currenciesMu.RUnlock()
+
+	d, ok := minorUnits[normalize(currency)]
+	return d, ok
+}
+
+// RegisterCurrency adds a currency, or replaces the minor units of a
+// currency, used by conversions.
+func RegisterCurrency(currency string, decimals uint8) {
+	currenciesMu.Lock()
+	defer currenciesMu.Unlock()
+
+	minorUnits[normalize(currency)] = decimals
+}
+
+func normalize(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
@@ -0,0 +1,146 @@
+package fiat_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jalavosus/go-ethunits"
+	"github.com/jalavosus/go-ethunits/fiat"
+)
+
+func mustQuote(t *testing.T, asset, currency, price string) fiat.Quote {
+	t.Helper()
+
+	q, err := fiat.NewQuote(asset, currency, price, time.Unix(1_714_521_600, 0))
+	require.NoError(t, err)
+
+	return q
+}
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   string
+		decimals uint8
+		quote    fiat.Quote
+		want     string
+	}{
+		{name: "ether to usd", amount: "1500000000000000000", decimals: 18, quote: mustQuote(t, "ETH", "USD", "3123.456"), want: "4685.18 USD"},
+		{name: "rounds up to cents", amount: "1600000000000000", decimals: 18, quote: mustQuote(t, "ETH", "USD", "3123.456"), want: "5.00 USD"},
+		{name: "half rounds up", amount: "5", decimals: 1, quote: mustQuote(t, "XYZ", "USD", "0.01"), want: "0.01 USD"},
+		{name: "below half rounds down", amount: "4", decimals: 1, quote: mustQuote(t, "XYZ", "USD", "0.01"), want: "0.00 USD"},
+		{name: "negative half rounds away from zero", amount: "-5", decimals: 1, quote: mustQuote(t, "XYZ", "USD", "0.01"), want: "-0.01 USD"},
+		{name: "no minor units", amount: "1500000000000000000", decimals: 18, quote: mustQuote(t, "ETH", "JPY", "487000"), want: "730500 JPY"},
+		{name: "no minor units,rounds", amount: "1100000000000", decimals: 18, quote: mustQuote(t, "ETH", "JPY", "487000"), want: "1 JPY"},
+		{name: "three minor units", amount: "2000000000000000000", decimals: 18, quote: mustQuote(t, "ETH", "KWD", "958.1234"), want: "1916.247 KWD"},
+		{name: "token", amount: "1234567891", decimals: 6, quote: mustQuote(t, "USDC", "EUR", "0.9215"), want: "1137.65 EUR"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			amount, ok := new(big.Int).SetString(tt.amount, 10)
+			require.True(t, ok)
+
+			got, err := fiat.Convert(amount, tt.decimals, tt.quote)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got.String())
+			assert.Equal(t, tt.quote.Timestamp, got.Timestamp)
+		})
+	}
+
+	_, err := fiat.Convert(big.NewInt(1), 0, mustQuote(t, "ETH", "XXX", "1"))
+	assert.ErrorIs(t, err, fiat.ErrUnknownCurrency)
+}
+
+func TestNewQuote(t *testing.T) {
+	q := mustQuote(t, "eth", "usd", "3123.450")
+	assert.Equal(t, "ETH", q.Asset)
+	assert.Equal(t, "USD", q.Currency)
+	assert.Equal(t, uint8(2), q.Decimals)
+	assert.Equal(t, big.NewRat(312345, 100), q.Rat())
+	assert.Equal(t, "1 ETH = 3123.45 USD", q.String())
+
+	q = mustQuote(t, "shib", "usd", "0.001")
+	assert.Equal(t, uint8(3), q.Decimals)
+	assert.Equal(t, "1", q.Price.String())
+
+	q = mustQuote(t, "eth", "usd", "3000.00")
+	assert.Equal(t, uint8(0), q.Decimals)
+	assert.Equal(t, "3000", q.Price.String())
+
+	for _, price := range []string{"", "abc", "-1", "1.2.3", "1/3", "1/4", "0x10", "0b11", "1e3", "1e-3"} {
+		_, err := fiat.NewQuote("ETH", "USD", price, time.Time{})
+		assert.Error(t, err, price)
+	}
+
+	assert.Equal(t, "1  = 0 ", fiat.Quote{}.String())
+	assert.Equal(t, 0, fiat.Quote{}.Rat().Sign())
+}
+
+func TestConverter(t *testing.T) {
+	src, err := fiat.NewFileSource("testdata/prices.json")
+	require.NoError(t, err)
+
+	c := fiat.NewConverter(src)
+	ctx := context.Background()
+
+	usdc := ethunits.Token{ChainID: ethunits.ChainEthereum, Symbol: "USDC", Decimals: 6}
+	got, err := c.ConvertToken(ctx, big.NewInt(1234567891), usdc, "eur")
+	require.NoError(t, err)
+	assert.Equal(t, "1137.65 EUR", got.String())
+	assert.Equal(t, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), got.Timestamp.UTC())
+
+	tenEther, _ := new(big.Int).SetString("10000000000000000000", 10)
+
+	got, err = c.ConvertWei(ctx, tenEther, ethunits.ChainPolygon, "USD")
+	require.NoError(t, err)
+	assert.Equal(t, "7.10 USD", got.String())
+
+	got, err = c.ConvertWei(ctx, tenEther, ethunits.ChainArbitrum, "JPY")
+	require.NoError(t, err)
+	assert.Equal(t, "4870000 JPY", got.String())
+
+	_, err = c.ConvertWei(ctx, tenEther, ethunits.ChainBSC, "USD")
+	assert.ErrorIs(t, err, fiat.ErrNoQuote)
+
+	_, err = c.ConvertWei(ctx, tenEther, 999999, "USD")
+	assert.ErrorIs(t, err, fiat.ErrUnknownChain)
+
+	_, err = fiat.NewFileSource("testdata/missing.json")
+	assert.Error(t, err)
+}
+
+func TestStaticSource(t *testing.T) {
+	src := fiat.NewStaticSource(mustQuote(t, "ETH", "USD", "3000"))
+	src.Set(mustQuote(t, "eth", "usd", "3100"))
+
+	q, err := src.Quote(context.Background(), "Eth", "Usd")
+	require.NoError(t, err)
+	assert.Equal(t, "1 ETH = 3100 USD", q.String())
+
+	_, err = src.Quote(context.Background(), "ETH", "EUR")
+	assert.ErrorIs(t, err, fiat.ErrNoQuote)
+}
+
+func TestMinorUnits(t *testing.T) {
+	d, ok := fiat.MinorUnits("usd")
+	assert.True(t, ok)
+	assert.Equal(t, uint8(2), d)
+
+	d, ok = fiat.MinorUnits("JPY")
+	assert.True(t, ok)
+	assert.Equal(t, uint8(0), d)
+
+	_, ok = fiat.MinorUnits("XTS")
+	assert.False(t, ok)
+
+	fiat.RegisterCurrency("xts", 4)
+	d, ok = fiat.MinorUnits("XTS")
+	assert.True(t, ok)
+	assert.Equal(t, uint8(4), d)
+}
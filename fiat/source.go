@@ -0,0 +1,186 @@
+package fiat
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jalavosus/go-ethunits"
+)
+
+var ErrNoQuote = errors.New("fiat: no quote available")
+
+// Quote is the price of one whole unit of an asset, such as "ETH" or a token
+// symbol, in a fiat currency. The price is Price / 10^Decimals.
+type Quote struct {
+	Asset     string
+	Currency  string
+	Price     *big.Int
+	Decimals  uint8
+	Timestamp time.Time
+}
+
+// pricePattern matches plain decimal prices, such as "3123.45" or ".5".
+var pricePattern = regexp.MustCompile(`^[+-]?(\d+\.?\d*|\.\d+)$`)
+
+// NewQuote returns a Quote for a plain decimal price string such as
+// "3123.45". The quote has the fewest decimals which represent the price
+// exactly, so "3123.450" is quoted with 2 decimals.
+func NewQuote(asset, currency, price string, timestamp time.Time) (Quote, error) {
+	s := strings.TrimSpace(price)
+	if !pricePattern.MatchString(s) {
+		return Quote{}, fmt.Errorf("fiat: invalid price %q", price)
+	}
+
+	decimals := 0
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		decimals = len(strings.TrimRight(s[i+1:], "0"))
+	}
+
+	if decimals > 255 {
+		return Quote{}, fmt.Errorf("fiat: invalid price %q", price)
+	}
+
+	p, ok := ethunits.ParseUnits(s, decimals)
+	if !ok || p.Sign() < 0 {
+		return Quote{}, fmt.Errorf("fiat: invalid price %q", price)
+	}
+
+	return Quote{
+		Asset:     normalize(asset),
+		Currency:  normalize(currency),
+		Price:     p,
+		Decimals:  uint8(decimals),
+		Timestamp: timestamp,
+	}, nil
+}
+
+// price returns q.Price, or zero for the zero Quote.
+func (q Quote) price() *big.Int {
+	if q.Price == nil {
+		return new(big.Int)
+	}
+
+	return q.Price
+}
+
+// Rat returns the quoted price as an exact fraction.
+func (q Quote) Rat() *big.Rat {
+	return new(big.Rat).SetFrac(q.price(), pow10(int(q.Decimals)))
+}
+
+func (q Quote) String() string {
+	return "1 " + q.Asset + " = " + ethunits.FormatUnits(q.price(), q.Decimals) + " " + q.Currency
+}
+
+// PriceSource provides quotes for assets in fiat currencies.
+// Implementations return an error wrapping ErrNoQuote if they have no quote
+// for the asset and currency.
+type PriceSource interface {
+	Quote(ctx context.Context, asset, currency string) (Quote, error)
+}
+
+type quoteKey struct {
+	asset    string
+	currency string
+}
+
+// StaticSource is an in-memory PriceSource. It is safe for concurrent use.
+type StaticSource struct {
+	mu     sync.RWMutex
+	quotes map[quoteKey]Quote
+}
+
+// NewStaticSource returns a StaticSource holding the given quotes.
+func NewStaticSource(quotes ...Quote) *StaticSource {
+	s := &StaticSource{quotes: make(map[quoteKey]Quote)}
+	for _, q := range quotes {
+		s.Set(q)
+	}
+
+	return s
+}
+
+// Set adds a quote, replacing any quote for the same asset and currency.
+func (s *StaticSource) Set(q Quote) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	q.Asset, q.Currency = normalize(q.Asset), normalize(q.Currency)
+	s.quotes[quoteKey{asset: q.Asset, currency: q.Currency}] = q
+}
+
+// Quote implements PriceSource. Asset and currency are case-insensitive.
+func (s *StaticSource) Quote(_ context.Context, asset, currency string) (Quote, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	q, ok := s.quotes[quoteKey{asset: normalize(asset), currency: normalize(currency)}]
+	if !ok {
+		return Quote{}, fmt.Errorf("%w: %s/%s", ErrNoQuote, normalize(asset), normalize(currency))
+	}
+
+	return q, nil
+}
+
+// FileSource is a PriceSource backed by a JSON file holding an array of
+// quotes, for tests and offline tooling:
+//
+//	[{"asset": "ETH", "currency": "USD", "price": "3123.45", "timestamp": "2024-05-01T00:00:00Z"}]
+type FileSource struct {
+	*StaticSource
+	path string
+}
+
+type fileQuote struct {
+	Asset     string    `json:"asset"`
+	Currency  string    `json:"currency"`
+	Price     string    `json:"price"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// NewFileSource returns a FileSource holding the quotes in the file at path.
+func NewFileSource(path string) (*FileSource, error) {
+	s := &FileSource{StaticSource: NewStaticSource(), path: path}
+	if err := s.Reload(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Reload replaces the source's quotes with the current contents of its file.
+func (s *FileSource) Reload() error {
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return err
+	}
+
+	var entries []fileQuote
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return fmt.Errorf("fiat: decoding %s: %w", s.path, err)
+	}
+
+	quotes := make(map[quoteKey]Quote, len(entries))
+	for i, e := range entries {
+		q, err := NewQuote(e.Asset, e.Currency, e.Price, e.Timestamp)
+		if err != nil {
+			return fmt.Errorf("fiat: %s: entry %d: %w", s.path, i, err)
+		}
+
+		quotes[quoteKey{asset: q.Asset, currency: q.Currency}] = q
+	}
+
+	s.mu.Lock()
+	s.quotes = quotes
+	s.mu.Unlock()
+
+	return nil
+}
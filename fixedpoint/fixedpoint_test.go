@@ -0,0 +1,255 @@
+package fixedpoint_test
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jalavosus/go-ethunits"
+	"github.com/jalavosus/go-ethunits/fixedpoint"
+)
+
+var maxUint256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+func bigInt(s string) *big.Int {
+	b, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		panic("invalid big.Int " + s)
+	}
+
+	return b
+}
+
+func mustWad(t *testing.T, raw string) fixedpoint.Wad {
+	t.Helper()
+	w, err := fixedpoint.NewWad(bigInt(raw))
+	require.NoError(t, err)
+	return w
+}
+
+func mustRay(t *testing.T, raw string) fixedpoint.Ray {
+	t.Helper()
+	r, err := fixedpoint.NewRay(bigInt(raw))
+	require.NoError(t, err)
+	return r
+}
+
+// halfUp is an independent reference implementation of
+// the Solidity libraries' rounding: floor(num/den + 1/2).
+func halfUp(num, den *big.Int) *big.Int {
+	n := new(big.Int).Mul(num, big.NewInt(2))
+	n.Add(n, den)
+	return n.Quo(n, new(big.Int).Mul(den, big.NewInt(2)))
+}
+
+func TestWad_Mul(t *testing.T) {
+	tests := []struct {
+		name    string
+		a, b    string
+		want    string
+		wantErr error
+	}{
+		{name: "1.5*2", a: "1500000000000000000", b: "2000000000000000000", want: "3000000000000000000"},
+		{name: "half rounds up", a: "1", b: "500000000000000000", want: "1"},
+		{name: "below half rounds down", a: "1", b: "499999999999999999", want: "0"},
+		{name: "zero", a: "0", b: maxUint256.String(), want: "0"},
+		{name: "overflow", a: maxUint256.String(), b: "2", wantErr: fixedpoint.ErrOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mustWad(t, tt.a).Mul(mustWad(t, tt.b))
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got.BigInt().String())
+		})
+	}
+}
+
+func TestWad_Div(t *testing.T) {
+	tests := []struct {
+		name    string
+		a, b    string
+		want    string
+		wantErr error
+	}{
+		{name: "3/2", a: "3000000000000000000", b: "2000000000000000000", want: "1500000000000000000"},
+		{name: "1/3", a: "1000000000000000000", b: "3000000000000000000", want: "333333333333333333"},
+		{name: "2/3 rounds up", a: "2000000000000000000", b: "3000000000000000000", want: "666666666666666667"},
+		{name: "division by zero", a: "1", b: "0", wantErr: fixedpoint.ErrDivisionByZero},
+		{name: "overflow", a: maxUint256.String(), b: "1", wantErr: fixedpoint.ErrOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mustWad(t, tt.a).Div(mustWad(t, tt.b))
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got.BigInt().String())
+		})
+	}
+}
+
+func TestRay_MulDiv(t *testing.T) {
+	one := mustRay(t, "1000000000000000000000000000")
+
+	got, err := mustRay(t, "1").Div(mustRay(t, "3"))
+	require.NoError(t, err)
+	assert.Equal(t, "333333333333333333333333333", got.BigInt().String())
+
+	got, err = mustRay(t, "2").Div(mustRay(t, "3"))
+	require.NoError(t, err)
+	assert.Equal(t, "666666666666666666666666667", got.BigInt().String())
+
+	got, err = mustRay(t, "1050000000000000000000000000").Mul(mustRay(t, "1050000000000000000000000000"))
+	require.NoError(t, err)
+	assert.Equal(t, "1.1025", got.String())
+
+	got, err = one.Mul(mustRay(t, "500000000000000000000000000"))
+	require.NoError(t, err)
+	assert.Equal(t, "0.5", got.String())
+
+	_, err = one.Div(fixedpoint.Ray{})
+	assert.ErrorIs(t, err, fixedpoint.ErrDivisionByZero)
+}
+
+func TestConversions(t *testing.T) {
+	tests := []struct {
+		name string
+		ray  string
+		wad  string
+	}{
+		{name: "exact", ray: "1500000000000000000000000000", wad: "1500000000000000000"},
+		{name: "half rounds up", ray: "1500000000", wad: "2"},
+		{name: "below half rounds down", ray: "1499999999", wad: "1"},
+		{name: "zero", ray: "0", wad: "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wad, mustRay(t, tt.ray).ToWad().BigInt().String())
+		})
+	}
+
+	ray, err := mustWad(t, "1500000000000000000").ToRay()
+	require.NoError(t, err)
+	assert.Equal(t, "1500000000000000000000000000", ray.BigInt().String())
+
+	_, err = mustWad(t, maxUint256.String()).ToRay()
+	assert.ErrorIs(t, err, fixedpoint.ErrOverflow)
+}
+
+func TestWad_Units(t *testing.T) {
+	usdc, err := fixedpoint.WadFromUnits(big.NewInt(1234560000), 6)
+	require.NoError(t, err)
+	assert.Equal(t, "1234.56", usdc.String())
+
+	back, err := usdc.ToUnits(6)
+	require.NoError(t, err)
+	assert.Equal(t, "1234560000", back.String())
+
+	rounded, err := mustWad(t, "1499999999999").ToUnits(6)
+	require.NoError(t, err)
+	assert.Equal(t, "1", rounded.String())
+
+	rounded, err = mustWad(t, "1500000000000").ToUnits(6)
+	require.NoError(t, err)
+	assert.Equal(t, "2", rounded.String())
+
+	gwei, err := fixedpoint.WadFromUnit(big.NewInt(30), ethunits.GWei)
+	require.NoError(t, err)
+	assert.Equal(t, "30000000000", gwei.BigInt().String())
+
+	wei, err := fixedpoint.WadFromUnit(bigInt("342500000000000000000"), ethunits.Wei)
+	require.NoError(t, err)
+	assert.Equal(t, "342.5", wei.String())
+
+	finney, err := gwei.ToUnit(ethunits.Finney)
+	require.NoError(t, err)
+	assert.Equal(t, "0", finney.String())
+
+	ether, err := fixedpoint.ParseWad("342.5")
+	require.NoError(t, err)
+	szabo, err := ether.ToUnit(ethunits.Szabo)
+	require.NoError(t, err)
+	assert.Equal(t, "342500000", szabo.String())
+
+	_, err = fixedpoint.WadFromUnit(big.NewInt(1), ethunits.Unknown)
+	assert.ErrorIs(t, err, fixedpoint.ErrUnknownUnit)
+
+	_, err = fixedpoint.ParseWad("0.0000000000000000001")
+	assert.ErrorIs(t, err, fixedpoint.ErrInvalidString)
+
+	_, err = fixedpoint.NewWad(big.NewInt(-1))
+	assert.ErrorIs(t, err, fixedpoint.ErrOutOfRange)
+}
+
+func TestCrossCheck(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	limit := new(big.Int).Lsh(big.NewInt(1), 100)
+
+	wad := bigInt("1000000000000000000")
+	ray := bigInt("1000000000000000000000000000")
+
+	for i := 0; i < 1000; i++ {
+		a := new(big.Int).Rand(rng, limit)
+		b := new(big.Int).Add(new(big.Int).Rand(rng, limit), big.NewInt(1))
+
+		wa, _ := fixedpoint.NewWad(a)
+		wb, _ := fixedpoint.NewWad(b)
+		ra, _ := fixedpoint.NewRay(a)
+		rb, _ := fixedpoint.NewRay(b)
+
+		got, err := wa.Mul(wb)
+		require.NoError(t, err)
+		assert.Equal(t, halfUp(new(big.Int).Mul(a, b), wad), got.BigInt())
+
+		got, err = wa.Div(wb)
+		require.NoError(t, err)
+		assert.Equal(t, halfUp(new(big.Int).Mul(a, wad), b), got.BigInt())
+
+		gotRay, err := ra.Mul(rb)
+		require.NoError(t, err)
+		assert.Equal(t, halfUp(new(big.Int).Mul(a, b), ray), gotRay.BigInt())
+
+		gotRay, err = ra.Div(rb)
+		require.NoError(t, err)
+		assert.Equal(t, halfUp(new(big.Int).Mul(a, ray), b), gotRay.BigInt())
+
+		assert.Equal(t, halfUp(a, bigInt("1000000000")), ra.ToWad().BigInt())
+	}
+}
+
+func TestRay_Pow(t *testing.T) {
+	two := mustRay(t, "2000000000000000000000000000")
+
+	got, err := two.Pow(10)
+	require.NoError(t, err)
+	assert.Equal(t, "1024", got.String())
+
+	got, err = mustRay(t, "1500000000000000000000000000").Pow(3)
+	require.NoError(t, err)
+	assert.Equal(t, "3.375", got.String())
+
+	got, err = two.Pow(0)
+	require.NoError(t, err)
+	assert.Equal(t, "1", got.String())
+
+	got, err = fixedpoint.Ray{}.Pow(5)
+	require.NoError(t, err)
+	assert.Equal(t, "0", got.String())
+
+	_, err = two.Pow(256)
+	assert.ErrorIs(t, err, fixedpoint.ErrOverflow)
+}
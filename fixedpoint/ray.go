@@ -0,0 +1,145 @@
+package fixedpoint
+
+import (
+	"math/big"
+
+	"github.com/jalavosus/go-ethunits"
+)
+
+// Ray is an unsigned fixed-point number with 27 decimals,
+// stored as a uint256 scaled by 1e27.
+// The zero value represents 0.
+type Ray struct {
+	v *big.Int
+}
+
+// NewRay returns the Ray whose raw uint256 representation is v.
+func NewRay(v *big.Int) (Ray, error) {
+	checked, err := checkUint256(new(big.Int).Set(v))
+	if err != nil {
+		return Ray{}, err
+	}
+
+	return Ray{v: checked}, nil
+}
+
+// ParseRay parses a decimal string such as "0.035" into a Ray.
+func ParseRay(s string) (Ray, error) {
+	v, err := parseFixed(s, RayDecimals)
+	if err != nil {
+		return Ray{}, err
+	}
+
+	return Ray{v: v}, nil
+}
+
+// RayFromUnits converts a raw amount of a currency with the given decimals
+// into a Ray.
+func RayFromUnits[T ethunits.DecimalValue](amount *big.Int, decimals T) (Ray, error) {
+	v, err := rescale(amount, int(decimals), RayDecimals)
+	if err != nil {
+		return Ray{}, err
+	}
+
+	return Ray{v: v}, nil
+}
+
+func (r Ray) raw() *big.Int {
+	if r.v == nil {
+		return new(big.Int)
+	}
+
+	return r.v
+}
+
+// BigInt returns a copy of the raw uint256 representation of r.
+func (r Ray) BigInt() *big.Int {
+	return new(big.Int).Set(r.raw())
+}
+
+// String returns r as an exact decimal string, e.g. "0.035".
+func (r Ray) String() string {
+	return ethunits.FormatUnits(r.raw(), RayDecimals)
+}
+
+// Cmp compares r and x, returning -1, 0 or +1.
+func (r Ray) Cmp(x Ray) int {
+	return r.raw().Cmp(x.raw())
+}
+
+// Add returns r + x.
+func (r Ray) Add(x Ray) (Ray, error) {
+	v, err := checkUint256(new(big.Int).Add(r.raw(), x.raw()))
+	return Ray{v: v}, err
+}
+
+// Sub returns r - x.
+func (r Ray) Sub(x Ray) (Ray, error) {
+	v := new(big.Int).Sub(r.raw(), x.raw())
+	if v.Sign() < 0 {
+		return Ray{}, ErrUnderflow
+	}
+
+	return Ray{v: v}, nil
+}
+
+// Mul returns r * x rounded half up, like WadRayMath.rayMul and DSMath.rmul.
+func (r Ray) Mul(x Ray) (Ray, error) {
+	v, err := mulHalfUp(r.raw(), x.raw(), rayUnit, halfRay)
+	return Ray{v: v}, err
+}
+
+// Div returns r / x rounded half up, like WadRayMath.rayDiv and DSMath.rdiv.
+func (r Ray) Div(x Ray) (Ray, error) {
+	v, err := divHalfUp(r.raw(), x.raw(), rayUnit)
+	return Ray{v: v}, err
+}
+
+// ToWad converts r into a Wad rounded half up, like WadRayMath.rayToWad.
+func (r Ray) ToWad() Wad {
+	q, rem := new(big.Int).QuoRem(r.raw(), wadRayRatio, new(big.Int))
+	if rem.Cmp(halfRayRatio) >= 0 {
+		q.Add(q, big.NewInt(1))
+	}
+
+	return Wad{v: q}
+}
+
+// ToUnits converts r into a raw amount of a currency with the given decimals,
+// rounding half up if the currency has fewer than 27 decimals.
+func (r Ray) ToUnits(decimals int) (*big.Int, error) {
+	return rescale(r.raw(), RayDecimals, decimals)
+}
+
+// Pow returns r^n, rounding half up at every step of exponentiation by
+// squaring, exactly like the rpow function of Maker's DSMath, Jug and Pot.
+func (r Ray) Pow(n uint64) (Ray, error) {
+	x := new(big.Int).Set(r.raw())
+	if x.Sign() == 0 {
+		if n == 0 {
+			return Ray{v: new(big.Int).Set(rayUnit)}, nil
+		}
+
+		return Ray{v: new(big.Int)}, nil
+	}
+
+	z := new(big.Int).Set(rayUnit)
+	if n%2 == 1 {
+		z.Set(x)
+	}
+
+	var err error
+	for n /= 2; n > 0; n /= 2 {
+		if x, err = mulHalfUp(x, x, rayUnit, halfRay); err != nil {
+			return Ray{}, err
+		}
+
+		if n%2 == 1 {
+			if z, err = mulHalfUp(z, x, rayUnit, halfRay); err != nil {
+				return Ray{}, err
+			}
+		}
+	}
+
+	return Ray{v: z}, nil
+}
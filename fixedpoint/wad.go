@@ -0,0 +1,129 @@
+package fixedpoint
+
+import (
+	"math/big"
+
+	"github.com/jalavosus/go-ethunits"
+)
+
+// Wad is an unsigned fixed-point number with 18 decimals,
+// stored as a uint256 scaled by 1e18.
+// The zero value represents 0.
+type Wad struct {
+	v *big.Int
+}
+
+// NewWad returns the Wad whose raw uint256 representation is v.
+func NewWad(v *big.Int) (Wad, error) {
+	checked, err := checkUint256(new(big.Int).Set(v))
+	if err != nil {
+		return Wad{}, err
+	}
+
+	return Wad{v: checked}, nil
+}
+
+// ParseWad parses a decimal string such as "1.05" into a Wad.
+func ParseWad(s string) (Wad, error) {
+	v, err := parseFixed(s, WadDecimals)
+	if err != nil {
+		return Wad{}, err
+	}
+
+	return Wad{v: v}, nil
+}
+
+// WadFromUnits converts a raw amount of a currency with the given decimals
+// into a Wad, rounding half up if the currency has more than 18 decimals.
+func WadFromUnits[T ethunits.DecimalValue](amount *big.Int, decimals T) (Wad, error) {
+	v, err := rescale(amount, int(decimals), WadDecimals)
+	if err != nil {
+		return Wad{}, err
+	}
+
+	return Wad{v: v}, nil
+}
+
+// WadFromUnit converts an amount denominated in u into a Wad of ether.
+// An amount of Wei is its own Wad representation.
+func WadFromUnit(amount *big.Int, u ethunits.Unit) (Wad, error) {
+	decimals, err := unitDecimals(u)
+	if err != nil {
+		return Wad{}, err
+	}
+
+	return WadFromUnits(amount, decimals)
+}
+
+func (w Wad) raw() *big.Int {
+	if w.v == nil {
+		return new(big.Int)
+	}
+
+	return w.v
+}
+
+// BigInt returns a copy of the raw uint256 representation of w.
+func (w Wad) BigInt() *big.Int {
+	return new(big.Int).Set(w.raw())
+}
+
+// String returns w as an exact decimal string, e.g. "1.05".
+func (w Wad) String() string {
+	return ethunits.FormatUnits(w.raw(), WadDecimals)
+}
+
+// Cmp compares w and x, returning -1, 0 or +1.
+func (w Wad) Cmp(x Wad) int {
+	return w.raw().Cmp(x.raw())
+}
+
+// Add returns w + x.
+func (w Wad) Add(x Wad) (Wad, error) {
+	v, err := checkUint256(new(big.Int).Add(w.raw(), x.raw()))
+	return Wad{v: v}, err
+}
+
+// Sub returns w - x.
+func (w Wad) Sub(x Wad) (Wad, error) {
+	v := new(big.Int).Sub(w.raw(), x.raw())
+	if v.Sign() < 0 {
+		return Wad{}, ErrUnderflow
+	}
+
+	return Wad{v: v}, nil
+}
+
+// Mul returns w * x rounded half up, like WadRayMath.wadMul and DSMath.wmul.
+func (w Wad) Mul(x Wad) (Wad, error) {
+	v, err := mulHalfUp(w.raw(), x.raw(), wadUnit, halfWad)
+	return Wad{v: v}, err
+}
+
+// Div returns w / x rounded half up, like WadRayMath.wadDiv and DSMath.wdiv.
+func (w Wad) Div(x Wad) (Wad, error) {
+	v, err := divHalfUp(w.raw(), x.raw(), wadUnit)
+	return Wad{v: v}, err
+}
+
+// ToRay converts w into a Ray, like WadRayMath.wadToRay.
+func (w Wad) ToRay() (Ray, error) {
+	v, err := checkUint256(new(big.Int).Mul(w.raw(), wadRayRatio))
+	return Ray{v: v}, err
+}
+
+// ToUnits converts w into a raw amount of a currency with the given decimals,
+// rounding half up if the currency has fewer than 18 decimals.
+func (w Wad) ToUnits(decimals int) (*big.Int, error) {
+	return rescale(w.raw(), WadDecimals, decimals)
+}
+
+// ToUnit converts a Wad of ether into an amount denominated in u.
+func (w Wad) ToUnit(u ethunits.Unit) (*big.Int, error) {
+	decimals, err := unitDecimals(u)
+	if err != nil {
+		return nil, err
+	}
+
+	return w.ToUnits(decimals)
+}
@@ -0,0 +1,160 @@
+package ethunits
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+var (
+	ErrInvalidAmount = errors.New("invalid amount")
+	ErrUnknownUnit   = errors.New("unknown unit")
+)
+
+var decimalPattern = regexp.MustCompile(`^[+-]?(\d+\.?\d*|\.\d+)([eE][+-]?\d+)?$`)
+
+// FormatUnits renders a raw integer amount of a currency with the given
+// number of decimals as an exact decimal string, e.g.
+// FormatUnits(big.NewInt(1500000), 6) returns "1.5".
+// Negative decimals are treated as zero.
+func FormatUnits[T DecimalValue](amount *big.Int, decimals T) string {
+	d := int(decimals)
+
+	digits := new(big.Int).Abs(amount).String()
+	if d > 0 {
+		if len(digits) <= d {
+			digits = strings.Repeat("0", d-len(digits)+1) + digits
+		}
+
+		whole, frac := digits[:len(digits)-d], strings.TrimRight(digits[len(digits)-d:], "0")
+		digits = whole
+		if frac != "" {
+			digits += "." + frac
+		}
+	}
+
+	if amount.Sign() < 0 {
+		digits = "-" + digits
+	}
+
+	return digits
+}
+
+// ParseUnits parses a decimal string such as "1.5" or "2e-3" into a raw
+// integer amount of a currency with the given number of decimals.
+// It returns false if the string isn't a decimal number, or if it has more
+// precision than the currency can represent. It also returns false if
+// decimals is negative.
+func ParseUnits[T DecimalValue](amount string, decimals T) (*big.Int, bool) {
+	if int(decimals) < 0 {
+		return nil, false
+	}
+
+	r, ok := parseDecimal(amount)
+	if !ok {
+		return nil, false
+	}
+
+	r.Mul(r, new(big.Rat).SetInt(pow10Int(int(decimals))))
+	if !r.IsInt() {
+		return nil, false
+	}
+
+	return new(big.Int).Set(r.Num()), true
+}
+
+// FormatWei renders an amount of Wei as an exact decimal amount of u,
+// e.g. FormatWei(big.NewInt(1500000000), GWei) returns "1.5".
+func FormatWei(wei *big.Int, u Unit) (string, bool) {
+	exp, ok := u.WeiExponent()
+	if !ok {
+		return "", false
+	}
+
+	return FormatUnits(wei, exp), true
+}
+
+// ParseAmount parses an amount such as "1.5 ether", "30gwei" or "0x4a817c800"
+// into an exact amount of Wei. Decimal amounts without a unit are read as
+// amounts of defaultUnit, and hexadecimal amounts are always amounts of Wei.
+// It returns false if the amount can't be parsed, names an unknown unit,
+// or isn't a whole number of Wei.
+func ParseAmount(s string, defaultUnit Unit) (*big.Int, bool) {
+	wei, _, err := parseAmount(s, defaultUnit)
+	return wei, err == nil
+}
+
+// parseAmount is like ParseAmount, also returning the unit the amount was
+// given in and an error describing why it couldn't be parsed.
+func parseAmount(s string, defaultUnit Unit) (*big.Int, Unit, error) {
+	s = strings.TrimSpace(s)
+
+	if len(s) > 2 && s[0] == '0' && (s[1] == 'x' || s[1] == 'X') {
+		fields := strings.Fields(s[2:])
+		if len(fields) == 2 && !strings.EqualFold(fields[1], "wei") {
+			return nil, Unknown, fmt.Errorf("%w %q: hexadecimal amounts must be in wei", ErrInvalidAmount, s)
+		}
+
+		var (
+			wei *big.Int
+			ok  bool
+		)
+		// The digits must follow the prefix directly, without a sign.
+		if (len(fields) == 1 || len(fields) == 2) && strings.HasPrefix(s[2:], fields[0]) && !strings.HasPrefix(fields[0], "+") {
+			wei, ok = new(big.Int).SetString(fields[0], 16)
+		}
+
+		if !ok || wei.Sign() < 0 {
+			return nil, Unknown, fmt.Errorf("%w %q: want a hexadecimal number of wei, such as \"0x4a817c800\"", ErrInvalidAmount, s)
+		}
+
+		return wei, Wei, nil
+	}
+
+	number := strings.TrimRightFunc(s, unicode.IsLetter)
+	unitName := s[len(number):]
+
+	if _, ok := parseDecimal(number); !ok {
+		return nil, Unknown, fmt.Errorf("%w %q: want a number with an optional unit, such as \"30gwei\" or \"0.1 ether\"", ErrInvalidAmount, s)
+	}
+
+	u := defaultUnit
+	if unitName != "" {
+		var ok bool
+		if u, ok = ParseUnit(unitName); !ok {
+			return nil, Unknown, fmt.Errorf("%w %q in %q", ErrUnknownUnit, unitName, s)
+		}
+	}
+
+	exp, ok := u.WeiExponent()
+	if !ok {
+		return nil, Unknown, fmt.Errorf("%w: %q has no unit", ErrUnknownUnit, s)
+	}
+
+	wei, ok := ParseUnits(number, exp)
+	if !ok {
+		return nil, Unknown, fmt.Errorf("%w %q: not a whole number of wei", ErrInvalidAmount, s)
+	}
+
+	return wei, u, nil
+}
+
+func formatFloat(f *big.Float) string {
+	return f.Text('f', -1)
+}
+
+func parseDecimal(s string) (*big.Rat, bool) {
+	s = strings.TrimSpace(s)
+	if !decimalPattern.MatchString(s) {
+		return nil, false
+	}
+
+	return new(big.Rat).SetString(s)
+}
+
+func pow10Int(exp int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exp)), nil)
+}
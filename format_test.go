@@ -0,0 +1,147 @@
+package ethunits_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jalavosus/go-ethunits"
+)
+
+func TestFormatUnits(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   *big.Int
+		decimals int
+		want     string
+	}{
+		{name: "decimals=18,amount=" + wantWeiStr, amount: makeBigInt(wantWeiStr), decimals: 18, want: wantEtherStr},
+		{name: "decimals=18,amount=" + wantWeiStr2, amount: makeBigInt(wantWeiStr2), decimals: 18, want: fromEtherStr2},
+		{name: "decimals=6,amount=1", amount: big.NewInt(1), decimals: 6, want: "0.000001"},
+		{name: "decimals=6,amount=-1500000", amount: big.NewInt(-1500000), decimals: 6, want: "-1.5"},
+		{name: "decimals=0,amount=42", amount: big.NewInt(42), decimals: 0, want: "42"},
+		{name: "decimals=8,amount=0", amount: big.NewInt(0), decimals: 8, want: "0"},
+		{name: "decimals=-2,amount=42", amount: big.NewInt(42), decimals: -2, want: "42"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ethunits.FormatUnits(tt.amount, tt.decimals))
+		})
+	}
+}
+
+func TestParseUnits(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   string
+		decimals uint8
+		want     *big.Int
+		wantOk   bool
+	}{
+		{name: "decimals=18,amount=" + fromEtherStr, amount: fromEtherStr, decimals: 18, want: makeBigInt(wantWeiStr), wantOk: true},
+		{name: "decimals=6,amount=0.000001", amount: "0.000001", decimals: 6, want: big.NewInt(1), wantOk: true},
+		{name: "decimals=6,amount=-1.5", amount: "-1.5", decimals: 6, want: big.NewInt(-1500000), wantOk: true},
+		{name: "decimals=18,amount=2e-3", amount: "2e-3", decimals: 18, want: makeBigInt("2000000000000000"), wantOk: true},
+		{name: "decimals=6,amount=.5", amount: ".5", decimals: 6, want: big.NewInt(500000), wantOk: true},
+		{name: "decimals=6,amount=0.0000001", amount: "0.0000001", decimals: 6, wantOk: false},
+		{name: "decimals=6,amount=1/2", amount: "1/2", decimals: 6, wantOk: false},
+		{name: "decimals=6,amount=abc", amount: "abc", decimals: 6, wantOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotOk := ethunits.ParseUnits(tt.amount, tt.decimals)
+			assert.Equal(t, tt.wantOk, gotOk)
+			if tt.want != nil {
+				assertBigIntEqual(t, tt.want, got)
+			}
+		})
+	}
+
+	_, ok := ethunits.ParseUnits("1", -1)
+	assert.False(t, ok)
+}
+
+func TestFormatWei(t *testing.T) {
+	tests := []struct {
+		name   string
+		unit   ethunits.Unit
+		want   string
+		wantOk bool
+	}{
+		{name: "unit=ether", unit: ethunits.Ether, want: wantEtherStr, wantOk: true},
+		{name: "unit=finney", unit: ethunits.Finney, want: "342500", wantOk: true},
+		{name: "unit=szabo", unit: ethunits.Szabo, want: fromSzaboStr, wantOk: true},
+		{name: "unit=gwei", unit: ethunits.GWei, want: fromGweiStr, wantOk: true},
+		{name: "unit=mwei", unit: ethunits.MWei, want: fromMweiStr, wantOk: true},
+		{name: "unit=kwei", unit: ethunits.KWei, want: fromKweiStr, wantOk: true},
+		{name: "unit=wei", unit: ethunits.Wei, want: wantWeiStr, wantOk: true},
+		{name: "unit=unknown", unit: ethunits.Unknown, wantOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotOk := ethunits.FormatWei(makeBigInt(wantWeiStr), tt.unit)
+			assert.Equal(t, tt.wantOk, gotOk)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		name        string
+		amount      string
+		defaultUnit ethunits.Unit
+		want        *big.Int
+		wantOk      bool
+	}{
+		{name: "amount=342.5 ether", amount: "342.5 ether", defaultUnit: ethunits.Wei, want: makeBigInt(wantWeiStr), wantOk: true},
+		{name: "amount=342.5ETH", amount: "342.5ETH", defaultUnit: ethunits.Wei, want: makeBigInt(wantWeiStr), wantOk: true},
+		{name: "amount=30 shannon", amount: "30 shannon", defaultUnit: ethunits.Ether, want: big.NewInt(30000000000), wantOk: true},
+		{name: "amount=2e-3ether", amount: "2e-3ether", defaultUnit: ethunits.Wei, want: makeBigInt("2000000000000000"), wantOk: true},
+		{name: "amount=1.5,default=gwei", amount: "1.5", defaultUnit: ethunits.GWei, want: big.NewInt(1500000000), wantOk: true},
+		{name: "amount=0x4a817c800", amount: "0x4a817c800", defaultUnit: ethunits.Ether, want: big.NewInt(20000000000), wantOk: true},
+		{name: "amount=0xff wei", amount: "0xff wei", defaultUnit: ethunits.Ether, want: big.NewInt(255), wantOk: true},
+		{name: "amount=0xff gwei", amount: "0xff gwei", defaultUnit: ethunits.Ether, wantOk: false},
+		{name: "amount=0XFF", amount: "0XFF", defaultUnit: ethunits.Ether, want: big.NewInt(255), wantOk: true},
+		{name: "amount=0x0Xff", amount: "0x0Xff", defaultUnit: ethunits.Ether, wantOk: false},
+		{name: "amount=0x+ff", amount: "0x+ff", defaultUnit: ethunits.Ether, wantOk: false},
+		{name: "amount=0x", amount: "0x", defaultUnit: ethunits.Ether, wantOk: false},
+		{name: "amount=0x 5", amount: "0x 5", defaultUnit: ethunits.Ether, wantOk: false},
+		{name: "amount=0.5 wei", amount: "0.5 wei", defaultUnit: ethunits.Ether, wantOk: false},
+		{name: "amount=1 dogecoin", amount: "1 dogecoin", defaultUnit: ethunits.Ether, wantOk: false},
+		{name: "amount=1,default=unknown", amount: "1", defaultUnit: ethunits.Unknown, wantOk: false},
+		{name: "amount=ether", amount: "ether", defaultUnit: ethunits.Ether, wantOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotOk := ethunits.ParseAmount(tt.amount, tt.defaultUnit)
+			assert.Equal(t, tt.wantOk, gotOk)
+			if tt.want != nil {
+				assertBigIntEqual(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestParseUnit(t *testing.T) {
+	for _, u := range ethunits.Units() {
+		got, ok := ethunits.ParseUnit(u.String())
+		assert.True(t, ok, u.String())
+		assert.Equal(t, u, got)
+	}
+
+	got, ok := ethunits.ParseUnit(" Shannon ")
+	assert.True(t, ok)
+	assert.Equal(t, ethunits.GWei, got)
+
+	got, ok = ethunits.ParseUnit("satoshi")
+	assert.False(t, ok)
+	assert.Equal(t, ethunits.Unknown, got)
+
+	assert.Equal(t, "Unit(42)", ethunits.Unit(42).String())
+}
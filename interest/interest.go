@@ -0,0 +1,225 @@
+// Package interest accrues interest on token amounts exactly like the
+// on-chain approximations of lending protocols such as Aave, Maker and Compound.
+//
+// The accrual functions model an amount deposited when the protocol's index
+// was one. Balances deposited at other indexes are stored on chain as scaled
+// balances; ScaledBalance and BalanceFromScaled reproduce them.
+package interest
+
+import (
+	"math/big"
+
+	"github.com/jalavosus/go-ethunits"
+	"github.com/jalavosus/go-ethunits/fixedpoint"
+)
+
+// SecondsPerYear is the year length used by Aave's MathUtils.
+const SecondsPerYear = 365 * 24 * 60 * 60
+
+var (
+	bigSecondsPerYear = big.NewInt(SecondsPerYear)
+	mantissaOne       = big.NewInt(1e18)
+	rayOne, _         = fixedpoint.ParseRay("1")
+)
+
+// Accrual is the result of accruing interest on a principal.
+// Amounts are raw token units.
+type Accrual struct {
+	Principal *big.Int
+	Interest  *big.Int
+	// Decimals are the decimals of the token, used for formatting.
+	Decimals uint8
+}
+
+// Balance returns the principal plus the accrued interest.
+func (a Accrual) Balance() *big.Int {
+	return new(big.Int).Add(a.Principal, a.Interest)
+}
+
+// FormatInterest renders the accrued interest in display units.
+func (a Accrual) FormatInterest() string {
+	return ethunits.FormatUnits(a.Interest, a.Decimals)
+}
+
+// FormatBalance renders the principal plus accrued interest in display units.
+func (a Accrual) FormatBalance() string {
+	return ethunits.FormatUnits(a.Balance(), a.Decimals)
+}
+
+// ScaledBalance returns the scaled balance stored for amount deposited or
+// borrowed at index, rayDiv(amount, index), like Aave's aToken and variable
+// debt token mint.
+func ScaledBalance(amount *big.Int, index fixedpoint.Ray) (*big.Int, error) {
+	a, err := fixedpoint.NewRay(amount)
+	if err != nil {
+		return nil, err
+	}
+
+	scaled, err := a.Div(index)
+	if err != nil {
+		return nil, err
+	}
+
+	return scaled.BigInt(), nil
+}
+
+// BalanceFromScaled returns the balance of a scaled balance at index,
+// rayMul(scaled, index), like Aave's aToken and variable debt token balanceOf.
+func BalanceFromScaled(scaled *big.Int, index fixedpoint.Ray) (*big.Int, error) {
+	s, err := fixedpoint.NewRay(scaled)
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := s.Mul(index)
+	if err != nil {
+		return nil, err
+	}
+
+	return balance.BigInt(), nil
+}
+
+// applyIndex accrues interest on principal deposited at an index of one,
+// whose index has since grown by factor. The scaled balance is then the
+// principal itself, and the balance is rayMul(principal, factor).
+func applyIndex(principal *big.Int, decimals uint8, factor fixedpoint.Ray) (Accrual, error) {
+	p, err := fixedpoint.NewRay(principal)
+	if err != nil {
+		return Accrual{}, err
+	}
+
+	balance, err := p.Mul(factor)
+	if err != nil {
+		return Accrual{}, err
+	}
+
+	interest := balance.BigInt()
+	interest.Sub(interest, principal)
+
+	return Accrual{Principal: principal, Interest: interest, Decimals: decimals}, nil
+}
+
+// AaveLinearFactor returns the index growth factor over the given number of
+// seconds at an annual rate, like Aave's MathUtils.calculateLinearInterest.
+// Aave applies it to supply balances.
+func AaveLinearFactor(rate fixedpoint.Ray, seconds uint64) (fixedpoint.Ray, error) {
+	v := new(big.Int).Mul(rate.BigInt(), new(big.Int).SetUint64(seconds))
+	v.Quo(v, bigSecondsPerYear)
+
+	interest, err := fixedpoint.NewRay(v)
+	if err != nil {
+		return fixedpoint.Ray{}, err
+	}
+
+	return rayOne.Add(interest)
+}
+
+// AaveCompoundedFactor returns the index growth factor over the given number
+// of seconds at an annual rate, using the three-term binomial approximation
+// of Aave's MathUtils.calculateCompoundedInterest.
+// Aave applies it to variable debt balances.
+func AaveCompoundedFactor(rate fixedpoint.Ray, seconds uint64) (fixedpoint.Ray, error) {
+	if seconds == 0 {
+		return rayOne, nil
+	}
+
+	exp := new(big.Int).SetUint64(seconds)
+	expMinusOne := new(big.Int).Sub(exp, big.NewInt(1))
+	expMinusTwo := new(big.Int)
+	if seconds > 2 {
+		expMinusTwo.Sub(exp, big.NewInt(2))
+	}
+
+	ratePowerTwo, err := rate.Mul(rate)
+	if err != nil {
+		return fixedpoint.Ray{}, err
+	}
+
+	basePowerTwo := ratePowerTwo.BigInt()
+	basePowerTwo.Quo(basePowerTwo, new(big.Int).Mul(bigSecondsPerYear, bigSecondsPerYear))
+
+	basePowerTwoRay, _ := fixedpoint.NewRay(basePowerTwo)
+	basePowerThreeRay, err := basePowerTwoRay.Mul(rate)
+	if err != nil {
+		return fixedpoint.Ray{}, err
+	}
+
+	basePowerThree := basePowerThreeRay.BigInt()
+	basePowerThree.Quo(basePowerThree, bigSecondsPerYear)
+
+	firstTerm := new(big.Int).Mul(rate.BigInt(), exp)
+	firstTerm.Quo(firstTerm, bigSecondsPerYear)
+
+	secondTerm := new(big.Int).Mul(exp, expMinusOne)
+	secondTerm.Mul(secondTerm, basePowerTwo)
+	secondTerm.Quo(secondTerm, big.NewInt(2))
+
+	thirdTerm := new(big.Int).Mul(exp, expMinusOne)
+	thirdTerm.Mul(thirdTerm, expMinusTwo)
+	thirdTerm.Mul(thirdTerm, basePowerThree)
+	thirdTerm.Quo(thirdTerm, big.NewInt(6))
+
+	sum := firstTerm.Add(firstTerm, secondTerm)
+	sum.Add(sum, thirdTerm)
+
+	interest, err := fixedpoint.NewRay(sum)
+	if err != nil {
+		return fixedpoint.Ray{}, err
+	}
+
+	return rayOne.Add(interest)
+}
+
+// AaveLinear accrues supply interest on a principal at an annual rate
+// over the given number of seconds, like a single update of Aave's
+// liquidity index.
+func AaveLinear(principal *big.Int, decimals uint8, rate fixedpoint.Ray, seconds uint64) (Accrual, error) {
+	factor, err := AaveLinearFactor(rate, seconds)
+	if err != nil {
+		return Accrual{}, err
+	}
+
+	return applyIndex(principal, decimals, factor)
+}
+
+// AaveCompounded accrues variable borrow interest on a principal at an annual
+// rate over the given number of seconds, like a single update of Aave's
+// variable borrow index.
+func AaveCompounded(principal *big.Int, decimals uint8, rate fixedpoint.Ray, seconds uint64) (Accrual, error) {
+	factor, err := AaveCompoundedFactor(rate, seconds)
+	if err != nil {
+		return Accrual{}, err
+	}
+
+	return applyIndex(principal, decimals, factor)
+}
+
+// MakerCompounded accrues interest on a principal at a per-second rate, such as
+// the DSR or a stability fee (e.g. 1.000000001547125957863212448 for 5% a year),
+// over the given number of seconds, like one call to Maker's Pot.drip or
+// Jug.drip.
+func MakerCompounded(principal *big.Int, decimals uint8, ratePerSecond fixedpoint.Ray, seconds uint64) (Accrual, error) {
+	chi, err := ratePerSecond.Pow(seconds)
+	if err != nil {
+		return Accrual{}, err
+	}
+
+	// Pot and Vat multiply with a rounded down rmul, unlike Aave's rayMul.
+	balance := new(big.Int).Mul(principal, chi.BigInt())
+	balance.Quo(balance, rayOne.BigInt())
+
+	return Accrual{Principal: principal, Interest: balance.Sub(balance, principal), Decimals: decimals}, nil
+}
+
+// CompoundV2 accrues interest on a principal at a per-block rate over the
+// given number of blocks in a single accrual, like one call to
+// CToken.accrueInterest. Chaining calls, with each balance as the next
+// principal, compounds interest like totalBorrows across accrueInterest calls.
+func CompoundV2(principal *big.Int, decimals uint8, ratePerBlock fixedpoint.Wad, blocks uint64) (Accrual, error) {
+	simpleInterestFactor := new(big.Int).Mul(ratePerBlock.BigInt(), new(big.Int).SetUint64(blocks))
+
+	interest := new(big.Int).Mul(simpleInterestFactor, principal)
+	interest.Quo(interest, mantissaOne)
+
+	return Accrual{Principal: principal, Interest: interest, Decimals: decimals}, nil
+}
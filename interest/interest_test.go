@@ -0,0 +1,124 @@
+package interest_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jalavosus/go-ethunits/fixedpoint"
+	"github.com/jalavosus/go-ethunits/interest"
+)
+
+const thirtyDays = 30 * 24 * 60 * 60
+
+func mustRay(t *testing.T, s string) fixedpoint.Ray {
+	t.Helper()
+	r, err := fixedpoint.ParseRay(s)
+	require.NoError(t, err)
+	return r
+}
+
+func TestAave(t *testing.T) {
+	rate := mustRay(t, "0.05")
+	usdc := big.NewInt(1000_000000)
+
+	tests := []struct {
+		name       string
+		accrue     func(*big.Int, uint8, fixedpoint.Ray, uint64) (interest.Accrual, error)
+		seconds    uint64
+		want       string
+		wantString string
+	}{
+		{name: "linear,year", accrue: interest.AaveLinear, seconds: interest.SecondsPerYear, want: "50000000", wantString: "1050"},
+		{name: "linear,30 days", accrue: interest.AaveLinear, seconds: thirtyDays, want: "4109589", wantString: "1004.109589"},
+		{name: "compounded,year", accrue: interest.AaveCompounded, seconds: interest.SecondsPerYear, want: "51265682", wantString: "1051.265682"},
+		{name: "compounded,30 days", accrue: interest.AaveCompounded, seconds: thirtyDays, want: "4118042", wantString: "1004.118042"},
+		{name: "compounded,zero", accrue: interest.AaveCompounded, seconds: 0, want: "0", wantString: "1000"},
+		{name: "compounded,one second", accrue: interest.AaveCompounded, seconds: 1, want: "2", wantString: "1000.000002"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.accrue(usdc, 6, rate, tt.seconds)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got.Interest.String())
+			assert.Equal(t, tt.wantString, got.FormatBalance())
+		})
+	}
+}
+
+func TestAaveCompoundedFactor(t *testing.T) {
+	factor, err := interest.AaveCompoundedFactor(mustRay(t, "0.05"), interest.SecondsPerYear)
+	require.NoError(t, err)
+
+	// The approximation slightly undershoots e^0.05 = 1.05127109637...
+	assert.Equal(t, "1.05126", factor.String()[:7])
+}
+
+func TestScaledBalance(t *testing.T) {
+	depositIndex := mustRay(t, "1.047262534098712459352187423")
+	currentIndex := mustRay(t, "1.089307125718230987231004552")
+
+	tests := []struct {
+		name        string
+		amount      *big.Int
+		wantScaled  string
+		wantBalance string
+		wantCurrent string
+	}{
+		{name: "1000 USDC", amount: big.NewInt(1000_000000), wantScaled: "954870405", wantBalance: "1000000000", wantCurrent: "1040147136"},
+		// rayDiv then rayMul at the same index loses a unit, as on chain.
+		{name: "rounding loss", amount: big.NewInt(1234567), wantScaled: "1178851", wantBalance: "1234566", wantCurrent: "1284131"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scaled, err := interest.ScaledBalance(tt.amount, depositIndex)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantScaled, scaled.String())
+
+			balance, err := interest.BalanceFromScaled(scaled, depositIndex)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantBalance, balance.String())
+
+			current, err := interest.BalanceFromScaled(scaled, currentIndex)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantCurrent, current.String())
+		})
+	}
+
+	_, err := interest.ScaledBalance(big.NewInt(1), fixedpoint.Ray{})
+	assert.ErrorIs(t, err, fixedpoint.ErrDivisionByZero)
+}
+
+func TestMakerCompounded(t *testing.T) {
+	dsr := mustRay(t, "1.000000001547125957863212448")
+	dai, _ := new(big.Int).SetString("1000000000000000000000", 10)
+
+	got, err := interest.MakerCompounded(dai, 18, dsr, interest.SecondsPerYear)
+	require.NoError(t, err)
+	assert.Equal(t, "49999999999999999961", got.Interest.String())
+	assert.Equal(t, "49.999999999999999961", got.FormatInterest())
+
+	factor, err := dsr.Pow(interest.SecondsPerYear)
+	require.NoError(t, err)
+	assert.Equal(t, "1049999999999999999961070145", factor.BigInt().String())
+
+	one, err := fixedpoint.Ray{}.Pow(0)
+	require.NoError(t, err)
+	assert.Equal(t, "1", one.String())
+}
+
+func TestCompoundV2(t *testing.T) {
+	ratePerBlock, err := fixedpoint.NewWad(big.NewInt(20_000_000_000))
+	require.NoError(t, err)
+
+	dai, _ := new(big.Int).SetString("1000000000000000000000", 10)
+
+	got, err := interest.CompoundV2(dai, 18, ratePerBlock, 2_102_400)
+	require.NoError(t, err)
+	assert.Equal(t, "42.048", got.FormatInterest())
+	assert.Equal(t, "1042.048", got.FormatBalance())
+}
@@ -0,0 +1,307 @@
+package ethunits
+
+import (
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Locale describes how a region writes numbers and currency amounts.
+type Locale struct {
+	// Tag is the locale's BCP 47 language tag, e.g. "de-DE".
+	Tag string
+	// Decimal separates the integer and fractional digits.
+	Decimal string
+	// Group separates groups of integer digits.
+	Group string
+	// Grouping lists the sizes of digit groups from the decimal separator
+	// leftwards; the last size repeats. Most locales use [3], and Indian
+	// locales use [3, 2], as in 1,23,45,678.
+	Grouping []int
+	// MinGroupingDigits is the number of digits the leftmost group must have
+	// for the integer part to be grouped; 2 means 1234 isn't grouped but
+	// 12345 is. Zero means 1.
+	MinGroupingDigits int
+	// SymbolFirst writes the currency symbol before the amount.
+	SymbolFirst bool
+}
+
+const (
+	nbsp       = "\u00a0"
+	narrowNbsp = "\u202f"
+)
+
+var (
+	localesMu sync.RWMutex
+	locales   = map[string]Locale{}
+)
+
+func init() {
+	for _, l := range []Locale{
+		{Tag: "en-US", Decimal: ".", Group: ",", Grouping: []int{3}},
+		{Tag: "en-GB", Decimal: ".", Group: ",", Grouping: []int{3}},
+		{Tag: "en-IN", Decimal: ".", Group: ",", Grouping: []int{3, 2}},
+		{Tag: "hi-IN", Decimal: ".", Group: ",", Grouping: []int{3, 2}},
+		{Tag: "de-DE", Decimal: ",", Group: ".", Grouping: []int{3}},
+		{Tag: "de-CH", Decimal: ".", Group: "’", Grouping: []int{3}, SymbolFirst: true},
+		{Tag: "fr-FR", Decimal: ",", Group: narrowNbsp, Grouping: []int{3}},
+		{Tag: "es-ES", Decimal: ",", Group: ".", Grouping: []int{3}, MinGroupingDigits: 2},
+		{Tag: "it-IT", Decimal: ",", Group: ".", Grouping: []int{3}},
+		{Tag: "nl-NL", Decimal: ",", Group: ".", Grouping: []int{3}, SymbolFirst: true},
+		{Tag: "pt-BR", Decimal: ",", Group: ".", Grouping: []int{3}},
+		{Tag: "pl-PL", Decimal: ",", Group: nbsp, Grouping: []int{3}, MinGroupingDigits: 2},
+		{Tag: "ru-RU", Decimal: ",", Group: nbsp, Grouping: []int{3}},
+		{Tag: "sv-SE", Decimal: ",", Group: nbsp, Grouping: []int{3}},
+		{Tag: "tr-TR", Decimal: ",", Group: ".", Grouping: []int{3}},
+		{Tag: "ja-JP", Decimal: ".", Group: ",", Grouping: []int{3}},
+		{Tag: "ko-KR", Decimal: ".", Group: ",", Grouping: []int{3}},
+		{Tag: "zh-CN", Decimal: ".", Group: ",", Grouping: []int{3}},
+	} {
+		locales[normalizeTag(l.Tag)] = l
+	}
+
+	// Languages without a region use their most common regional convention.
+	for lang, tag := range map[string]string{
+		"en": "en-US", "hi": "hi-IN", "de": "de-DE", "fr": "fr-FR", "es": "es-ES",
+		"it": "it-IT", "nl": "nl-NL", "pt": "pt-BR", "pl": "pl-PL", "ru": "ru-RU",
+		"sv": "sv-SE", "tr": "tr-TR", "ja": "ja-JP", "ko": "ko-KR", "zh": "zh-CN",
+	} {
+		locales[lang] = locales[normalizeTag(tag)]
+	}
+}
+
+// LocaleByTag returns the locale with the given language tag, such as "de-DE"
+// or "en_IN", ignoring case. Tags of unknown regions fall back to the
+// locale of their language, e.g. "de-AT" to "de-DE".
+func LocaleByTag(tag string) (Locale, bool) {
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+
+	tag = normalizeTag(tag)
+	if l, ok := locales[tag]; ok {
+		return l, true
+	}
+
+	lang, _, _ := strings.Cut(tag, "-")
+	l, ok := locales[lang]
+
+	return l, ok
+}
+
+// RegisterLocale adds a locale, replacing any locale with the same tag.
+func RegisterLocale(l Locale) {
+	localesMu.Lock()
+	defer localesMu.Unlock()
+
+	locales[normalizeTag(l.Tag)] = l
+}
+
+// Locales returns the tags of every registered locale which names a region,
+// in sorted order.
+func Locales() []string {
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+
+	seen := make(map[string]bool, len(locales))
+
+	var tags []string
+	for _, l := range locales {
+		if l.Tag != "" && !seen[l.Tag] {
+			seen[l.Tag] = true
+			tags = append(tags, l.Tag)
+		}
+	}
+
+	sort.Strings(tags)
+
+	return tags
+}
+
+// FormatUnits renders a raw amount of a currency with the given number of
+// decimals as an exact decimal in the locale's conventions, e.g. "1.234,56".
+func (l Locale) FormatUnits(amount *big.Int, decimals uint8) string {
+	return l.localize(FormatUnits(amount, decimals))
+}
+
+// FormatEther renders an amount returned by ToEther in the locale's conventions.
+func (l Locale) FormatEther(amount *big.Float) string {
+	return l.localize(formatFloat(amount))
+}
+
+// FormatWei renders an amount of Wei in u in the locale's conventions,
+// with the unit's symbol, e.g. "1.234,56 ETH". It returns false if u is unknown.
+func (l Locale) FormatWei(wei *big.Int, u Unit) (string, bool) {
+	s, ok := FormatWei(wei, u)
+	if !ok {
+		return "", false
+	}
+
+	return l.WithSymbol(l.localize(s), unitSymbol(u)), true
+}
+
+// WithSymbol places a currency symbol before or after a formatted amount,
+// as the locale does.
+func (l Locale) WithSymbol(amount, symbol string) string {
+	if l.SymbolFirst {
+		return symbol + " " + amount
+	}
+
+	return amount + " " + symbol
+}
+
+// ParseUnits parses a decimal amount written in the locale's conventions,
+// such as "1.234,56", into a raw amount of a currency with the given number
+// of decimals. Group separators are optional, but must be placed as the
+// locale places them.
+func (l Locale) ParseUnits(amount string, decimals uint8) (*big.Int, bool) {
+	s, ok := l.delocalize(strings.TrimSpace(amount))
+	if !ok {
+		return nil, false
+	}
+
+	return ParseUnits(s, decimals)
+}
+
+// ParseAmount is like the package-level ParseAmount, for amounts written in the locale's
+// conventions with an optional unit before or after them, such as
+// "1.234,56 ETH" or "ETH 1’234.56".
+func (l Locale) ParseAmount(amount string, defaultUnit Unit) (*big.Int, bool) {
+	s := strings.TrimSpace(amount)
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		return ParseAmount(s, defaultUnit)
+	}
+
+	number := strings.TrimFunc(s, unicode.IsLetter)
+	i := strings.Index(s, number)
+
+	prefix, suffix := strings.TrimSpace(s[:i]), strings.TrimSpace(s[i+len(number):])
+	if prefix != "" && suffix != "" {
+		return nil, false
+	}
+
+	n, ok := l.delocalize(strings.TrimSpace(number))
+	if !ok {
+		return nil, false
+	}
+
+	return ParseAmount(n+prefix+suffix, defaultUnit)
+}
+
+// localize rewrites a plain decimal string, such as "-1234.5",
+// in the locale's conventions.
+func (l Locale) localize(s string) string {
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+
+	whole, frac, hasFrac := strings.Cut(s, ".")
+
+	grouped := strings.Join(l.groups(whole), l.Group)
+	if hasFrac {
+		grouped += l.Decimal + frac
+	}
+
+	return sign + grouped
+}
+
+// groups splits integer digits into the locale's groups, leftmost first.
+func (l Locale) groups(digits string) []string {
+	sizes := l.Grouping
+	if len(sizes) == 0 || l.Group == "" {
+		return []string{digits}
+	}
+
+	minDigits := l.MinGroupingDigits
+	if minDigits < 1 {
+		minDigits = 1
+	}
+
+	if len(digits) < sizes[0]+minDigits {
+		return []string{digits}
+	}
+
+	var groups []string
+	for i := 0; len(digits) > 0; i++ {
+		size := sizes[len(sizes)-1]
+		if i < len(sizes) {
+			size = sizes[i]
+		}
+
+		if size <= 0 || size >= len(digits) {
+			groups = append(groups, digits)
+			break
+		}
+
+		groups = append(groups, digits[len(digits)-size:])
+		digits = digits[:len(digits)-size]
+	}
+
+	// Reverse into reading order.
+	for i, j := 0, len(groups)-1; i < j; i, j = i+1, j-1 {
+		groups[i], groups[j] = groups[j], groups[i]
+	}
+
+	return groups
+}
+
+// delocalize rewrites a number in the locale's conventions as a plain
+// decimal string, checking that any group separators are correctly placed.
+func (l Locale) delocalize(s string) (string, bool) {
+	sign := ""
+	if r, size := utf8.DecodeRuneInString(s); r == '-' || r == '+' || r == '\u2212' {
+		sign, s = "-", s[size:]
+		if r == '+' {
+			sign = ""
+		}
+	}
+
+	if strings.Count(s, l.Decimal) > 1 {
+		return "", false
+	}
+
+	whole, frac, hasFrac := strings.Cut(s, l.Decimal)
+
+	if l.Group != "" {
+		whole = l.normalizeGroups(whole)
+
+		if strings.Contains(whole, l.Group) {
+			digits := strings.ReplaceAll(whole, l.Group, "")
+			if strings.Join(l.groups(digits), l.Group) != whole {
+				return "", false
+			}
+			whole = digits
+		}
+	}
+
+	if hasFrac {
+		return sign + whole + "." + frac, true
+	}
+
+	return sign + whole, true
+}
+
+// groupAlternates lists the characters typed in place of group separators
+// which are hard to type.
+var groupAlternates = map[string][]string{
+	nbsp:       {" ", narrowNbsp},
+	narrowNbsp: {" ", nbsp},
+	"’":        {"'"},
+}
+
+// normalizeGroups replaces the alternates of the locale's group separator
+// with the separator itself.
+func (l Locale) normalizeGroups(s string) string {
+	for _, alt := range groupAlternates[l.Group] {
+		s = strings.ReplaceAll(s, alt, l.Group)
+	}
+
+	return s
+}
+
+func normalizeTag(tag string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(tag), "_", "-"))
+}
@@ -0,0 +1,141 @@
+package ethunits_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jalavosus/go-ethunits"
+)
+
+func mustLocale(t *testing.T, tag string) ethunits.Locale {
+	t.Helper()
+
+	l, ok := ethunits.LocaleByTag(tag)
+	require.True(t, ok, tag)
+
+	return l
+}
+
+func TestLocaleFormat(t *testing.T) {
+	amount := makeBigInt("1234567891234567890000000") // 1234567.89123456789 ether
+
+	tests := []struct {
+		tag   string
+		want  string
+		small string
+	}{
+		{tag: "en-US", want: "1,234,567.89123456789 ETH", small: "1,234.5"},
+		{tag: "en-IN", want: "12,34,567.89123456789 ETH", small: "1,234.5"},
+		{tag: "de-DE", want: "1.234.567,89123456789 ETH", small: "1.234,5"},
+		{tag: "de-CH", want: "ETH 1’234’567.89123456789", small: "1’234.5"},
+		{tag: "fr-FR", want: "1\u202f234\u202f567,89123456789 ETH", small: "1\u202f234,5"},
+		{tag: "es-ES", want: "1.234.567,89123456789 ETH", small: "1234,5"},
+		{tag: "pl-PL", want: "1\u00a0234\u00a0567,89123456789 ETH", small: "1234,5"},
+		{tag: "nl-NL", want: "ETH 1.234.567,89123456789", small: "1.234,5"},
+		{tag: "ja-JP", want: "1,234,567.89123456789 ETH", small: "1,234.5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			l := mustLocale(t, tt.tag)
+
+			got, ok := l.FormatWei(amount, ethunits.Ether)
+			require.True(t, ok)
+			assert.Equal(t, tt.want, got)
+
+			assert.Equal(t, tt.small, l.FormatUnits(big.NewInt(12345), 1))
+
+			back, ok := l.ParseAmount(got, ethunits.Wei)
+			require.True(t, ok)
+			assertBigIntEqual(t, amount, back)
+		})
+	}
+
+	en := mustLocale(t, "en-US")
+	assert.Equal(t, "123.4", en.FormatUnits(big.NewInt(1234), 1))
+	assert.Equal(t, "-1,000", en.FormatUnits(big.NewInt(-1000), 0))
+	assert.Equal(t, "100", en.FormatUnits(big.NewInt(100), 0))
+
+	ether, ok := ethunits.ToEther(wantWeiStr, ethunits.Wei)
+	require.True(t, ok)
+	assert.Equal(t, "342,5", mustLocale(t, "de").FormatEther(ether))
+
+	got, ok := mustLocale(t, "en-IN").FormatWei(makeBigInt("123456780000000000"), ethunits.GWei)
+	require.True(t, ok)
+	assert.Equal(t, "12,34,56,780 gwei", got)
+}
+
+func TestLocaleParse(t *testing.T) {
+	tests := []struct {
+		tag    string
+		input  string
+		want   string
+		wantOk bool
+	}{
+		{tag: "de-DE", input: "1.234,56", want: "123456", wantOk: true},
+		{tag: "de-DE", input: "1234,56", want: "123456", wantOk: true},
+		{tag: "de-DE", input: "-0,5", want: "-50", wantOk: true},
+		{tag: "de-DE", input: "1,234.56", wantOk: false},
+		{tag: "de-DE", input: "12.34,56", wantOk: false},
+		{tag: "de-DE", input: "1,2,3", wantOk: false},
+		{tag: "en-US", input: "1,234.56", want: "123456", wantOk: true},
+		{tag: "en-US", input: "1.234", wantOk: false},
+		{tag: "en-IN", input: "1,23,456.78", want: "12345678", wantOk: true},
+		{tag: "en-IN", input: "123,456.78", wantOk: false},
+		{tag: "fr-FR", input: "1 234,5", want: "123450", wantOk: true},
+		{tag: "de-CH", input: "1'234.5", want: "123450", wantOk: true},
+		{tag: "es-ES", input: "12.345,6", want: "1234560", wantOk: true},
+		{tag: "es-ES", input: "1.234,5", wantOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tag+","+tt.input, func(t *testing.T) {
+			got, ok := mustLocale(t, tt.tag).ParseUnits(tt.input, 2)
+			assert.Equal(t, tt.wantOk, ok)
+			if tt.wantOk {
+				assert.Equal(t, tt.want, got.String())
+			}
+		})
+	}
+
+	de := mustLocale(t, "de-DE")
+
+	wei, ok := de.ParseAmount("1.234,5 gwei", ethunits.Ether)
+	require.True(t, ok)
+	assert.Equal(t, "1234500000000", wei.String())
+
+	wei, ok = de.ParseAmount("0,5", ethunits.Ether)
+	require.True(t, ok)
+	assert.Equal(t, "500000000000000000", wei.String())
+
+	wei, ok = de.ParseAmount("0x10", ethunits.Ether)
+	require.True(t, ok)
+	assert.Equal(t, "16", wei.String())
+
+	_, ok = de.ParseAmount("ETH 1 ETH", ethunits.Ether)
+	assert.False(t, ok)
+}
+
+func TestLocaleByTag(t *testing.T) {
+	for _, tag := range []string{"de_de", "DE-de", "de-AT", "de"} {
+		l, ok := ethunits.LocaleByTag(tag)
+		assert.True(t, ok, tag)
+		assert.Equal(t, "de-DE", l.Tag, tag)
+	}
+
+	_, ok := ethunits.LocaleByTag("xx-YY")
+	assert.False(t, ok)
+
+	ethunits.RegisterLocale(ethunits.Locale{Tag: "x-test", Decimal: "·", Group: "_", Grouping: []int{4}})
+	l, ok := ethunits.LocaleByTag("X-Test")
+	require.True(t, ok)
+	assert.Equal(t, "1_2345_6789·5", l.FormatUnits(big.NewInt(1234567895), 1))
+
+	tags := ethunits.Locales()
+	assert.Contains(t, tags, "en-IN")
+	assert.Contains(t, tags, "x-test")
+	assert.IsIncreasing(t, tags)
+}
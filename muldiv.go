@@ -0,0 +1,67 @@
+package ethunits
+
+import (
+	"errors"
+	"math/big"
+)
+
+// Rounding selects the direction in which a division is rounded.
+// Its values mirror OpenZeppelin's Math.Rounding enum.
+type Rounding uint8
+
+const (
+	// RoundFloor rounds toward negative infinity.
+	RoundFloor Rounding = iota
+	// RoundCeil rounds toward positive infinity.
+	RoundCeil
+	// RoundTrunc rounds toward zero.
+	RoundTrunc
+	// RoundExpand rounds away from zero.
+	RoundExpand
+)
+
+var (
+	ErrMulDivOverflow = errors.New("mulDiv result overflows uint256")
+	ErrDivisionByZero = errors.New("division by zero")
+	ErrNotUint256     = errors.New("value is outside the uint256 range")
+)
+
+var maxUint256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+// roundsUp reports whether r rounds an unsigned quotient up,
+// like OpenZeppelin's Math.unsignedRoundsUp.
+func (r Rounding) roundsUp() bool {
+	return r%2 == 1
+}
+
+// MulDiv computes x * y / denominator with full precision, rounding in the
+// given direction, exactly like OpenZeppelin's Math.mulDiv.
+// All arguments must be uint256 values, such as amounts of Wei returned by ToWei.
+// Where the contract would revert, MulDiv returns ErrDivisionByZero if
+// denominator is zero, and ErrMulDivOverflow if the result doesn't fit in a uint256.
+func MulDiv(x, y, denominator *big.Int, rounding Rounding) (*big.Int, error) {
+	for _, v := range []*big.Int{x, y, denominator} {
+		if !isUint256(v) {
+			return nil, ErrNotUint256
+		}
+	}
+
+	if denominator.Sign() == 0 {
+		return nil, ErrDivisionByZero
+	}
+
+	q, r := new(big.Int).QuoRem(new(big.Int).Mul(x, y), denominator, new(big.Int))
+	if rounding.roundsUp() && r.Sign() > 0 {
+		q.Add(q, big.NewInt(1))
+	}
+
+	if q.Cmp(maxUint256) > 0 {
+		return nil, ErrMulDivOverflow
+	}
+
+	return q, nil
+}
+
+func isUint256(v *big.Int) bool {
+	return v.Sign() >= 0 && v.Cmp(maxUint256) <= 0
+}
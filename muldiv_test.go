@@ -0,0 +1,78 @@
+package ethunits_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jalavosus/go-ethunits"
+)
+
+var maxUint256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+func TestMulDiv(t *testing.T) {
+	tests := []struct {
+		name     string
+		x, y, d  *big.Int
+		rounding ethunits.Rounding
+		want     *big.Int
+		wantErr  error
+	}{
+		{name: "exact", x: big.NewInt(6), y: big.NewInt(4), d: big.NewInt(3), rounding: ethunits.RoundCeil, want: big.NewInt(8)},
+		{name: "floor", x: big.NewInt(7), y: big.NewInt(1), d: big.NewInt(2), rounding: ethunits.RoundFloor, want: big.NewInt(3)},
+		{name: "ceil", x: big.NewInt(7), y: big.NewInt(1), d: big.NewInt(2), rounding: ethunits.RoundCeil, want: big.NewInt(4)},
+		{name: "trunc", x: big.NewInt(7), y: big.NewInt(1), d: big.NewInt(2), rounding: ethunits.RoundTrunc, want: big.NewInt(3)},
+		{name: "expand", x: big.NewInt(7), y: big.NewInt(1), d: big.NewInt(2), rounding: ethunits.RoundExpand, want: big.NewInt(4)},
+		{
+			name:     "512-bit intermediate",
+			x:        maxUint256,
+			y:        maxUint256,
+			d:        maxUint256,
+			rounding: ethunits.RoundFloor,
+			want:     maxUint256,
+		},
+		{
+			name:     "512-bit intermediate,ceil",
+			x:        maxUint256,
+			y:        new(big.Int).Sub(maxUint256, big.NewInt(1)),
+			d:        maxUint256,
+			rounding: ethunits.RoundCeil,
+			want:     new(big.Int).Sub(maxUint256, big.NewInt(1)),
+		},
+		{name: "wei share", x: makeBigInt(wantWeiStr), y: big.NewInt(1), d: big.NewInt(3), rounding: ethunits.RoundFloor, want: makeBigInt("114166666666666666666")},
+		{name: "overflow", x: maxUint256, y: big.NewInt(2), d: big.NewInt(1), rounding: ethunits.RoundFloor, wantErr: ethunits.ErrMulDivOverflow},
+		{
+			name:     "overflow when rounding up",
+			x:        new(big.Int).Sub(maxUint256, big.NewInt(1)),
+			y:        new(big.Int).Sub(maxUint256, big.NewInt(1)),
+			d:        new(big.Int).Sub(maxUint256, big.NewInt(2)),
+			rounding: ethunits.RoundCeil,
+			wantErr:  ethunits.ErrMulDivOverflow,
+		},
+		{
+			name:     "no overflow when rounding down",
+			x:        new(big.Int).Sub(maxUint256, big.NewInt(1)),
+			y:        new(big.Int).Sub(maxUint256, big.NewInt(1)),
+			d:        new(big.Int).Sub(maxUint256, big.NewInt(2)),
+			rounding: ethunits.RoundFloor,
+			want:     maxUint256,
+		},
+		{name: "division by zero", x: big.NewInt(1), y: big.NewInt(1), d: big.NewInt(0), rounding: ethunits.RoundFloor, wantErr: ethunits.ErrDivisionByZero},
+		{name: "negative", x: big.NewInt(-1), y: big.NewInt(1), d: big.NewInt(1), rounding: ethunits.RoundFloor, wantErr: ethunits.ErrNotUint256},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ethunits.MulDiv(tt.x, tt.y, tt.d, tt.rounding)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assertBigIntEqual(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package ethunits
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+)
+
+var ErrInvalidPercent = errors.New("percentage must be between 0% and 100%")
+
+var (
+	ratOne     = big.NewRat(1, 1)
+	ratHundred = big.NewRat(100, 1)
+)
+
+// Percent is an exact, non-negative percentage such as 0.3% or 12.5%.
+// The zero value represents 0%.
+type Percent struct {
+	r *big.Rat
+}
+
+// NewPercent returns the Percent equal to num/den percent.
+// It returns false if den is zero or the percentage is negative.
+func NewPercent(num, den int64) (Percent, bool) {
+	if den == 0 {
+		return Percent{}, false
+	}
+
+	r := new(big.Rat).SetFrac64(num, den)
+
+	return percentFromFraction(r.Quo(r, ratHundred))
+}
+
+// ParsePercent parses a percentage given with a "%" suffix ("0.3%"), or in
+// basis points with a "bps" suffix ("30bps"). Unlike ParseBps, which reads a
+// bare number as basis points, ParsePercent rejects numbers without a suffix.
+func ParsePercent(s string) (Percent, bool) {
+	s = strings.ToLower(strings.TrimSpace(s))
+
+	var denom int64
+	switch {
+	case strings.HasSuffix(s, "%"):
+		s = strings.TrimSuffix(s, "%")
+		denom = 100
+	case strings.HasSuffix(s, "bps"):
+		s = strings.TrimSuffix(s, "bps")
+		denom = 10000
+	case strings.HasSuffix(s, "bp"):
+		s = strings.TrimSuffix(s, "bp")
+		denom = 10000
+	default:
+		return Percent{}, false
+	}
+
+	r, ok := parseDecimal(s)
+	if !ok {
+		return Percent{}, false
+	}
+
+	return percentFromFraction(r.Quo(r, big.NewRat(denom, 1)))
+}
+
+func percentFromFraction(r *big.Rat) (Percent, bool) {
+	if r.Sign() < 0 {
+		return Percent{}, false
+	}
+
+	return Percent{r: r}, true
+}
+
+// Percent returns b as a Percent.
+func (b Bps) Percent() Percent {
+	return Percent{r: new(big.Rat).SetFrac(b.bigInt(), MaxBps.bigInt())}
+}
+
+func (p Percent) fraction() *big.Rat {
+	if p.r == nil {
+		return new(big.Rat)
+	}
+
+	return p.r
+}
+
+// Rat returns p as a fraction, e.g. 3/1000 for 0.3%.
+func (p Percent) Rat() *big.Rat {
+	return new(big.Rat).Set(p.fraction())
+}
+
+// Bps returns p in basis points.
+// It returns false if p isn't a whole number of basis points.
+func (p Percent) Bps() (Bps, bool) {
+	r := new(big.Rat).Mul(p.fraction(), new(big.Rat).SetInt(MaxBps.bigInt()))
+	if !r.IsInt() || !r.Num().IsUint64() || r.Num().Uint64() > uint64(^uint32(0)) {
+		return 0, false
+	}
+
+	return Bps(r.Num().Uint64()), true
+}
+
+// String returns p as a decimal percentage, e.g. "0.3%".
+// Percentages without a finite decimal representation are rounded
+// to 18 decimal places.
+func (p Percent) String() string {
+	r := new(big.Rat).Mul(p.fraction(), ratHundred)
+
+	s := r.FloatString(18)
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+
+	return s + "%"
+}
+
+// Of returns p percent of amount, rounded in the given direction.
+func (p Percent) Of(amount *big.Int, rounding Rounding) (*big.Int, error) {
+	f := p.fraction()
+	return MulDiv(amount, f.Num(), f.Denom(), rounding)
+}
+
+// Deduct returns what remains of amount after deducting p percent of it,
+// where the deducted part is rounded in the given direction.
+func (p Percent) Deduct(amount *big.Int, rounding Rounding) (*big.Int, error) {
+	if p.fraction().Cmp(ratOne) > 0 {
+		return nil, ErrInvalidPercent
+	}
+
+	part, err := p.Of(amount, rounding)
+	if err != nil {
+		return nil, err
+	}
+
+	return part.Sub(amount, part), nil
+}
+
+// GrossUp returns the amount which leaves net after deducting p percent of it,
+// that is net / (1 - p), rounded in the given direction.
+// With RoundCeil the result never leaves less than net after
+// Deduct(result, RoundFloor).
+func (p Percent) GrossUp(net *big.Int, rounding Rounding) (*big.Int, error) {
+	remainder := new(big.Rat).Sub(ratOne, p.fraction())
+	if remainder.Sign() <= 0 {
+		return nil, ErrInvalidPercent
+	}
+
+	return MulDiv(net, remainder.Denom(), remainder.Num(), rounding)
+}
+
+// Of returns b basis points of amount, rounded in the given direction.
+func (b Bps) Of(amount *big.Int, rounding Rounding) (*big.Int, error) {
+	return b.Percent().Of(amount, rounding)
+}
+
+// Deduct returns what remains of amount after deducting b basis points of it,
+// where the deducted part is rounded in the given direction.
+func (b Bps) Deduct(amount *big.Int, rounding Rounding) (*big.Int, error) {
+	return b.Percent().Deduct(amount, rounding)
+}
+
+// GrossUp returns the amount which leaves net after deducting b basis points
+// of it, rounded in the given direction.
+func (b Bps) GrossUp(net *big.Int, rounding Rounding) (*big.Int, error) {
+	return b.Percent().GrossUp(net, rounding)
+}
@@ -0,0 +1,122 @@
+package ethunits_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jalavosus/go-ethunits"
+)
+
+func mustPercent(t *testing.T, s string) ethunits.Percent {
+	t.Helper()
+	p, ok := ethunits.ParsePercent(s)
+	require.Truef(t, ok, "ParsePercent(%q)", s)
+	return p
+}
+
+func TestParsePercent(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantBps ethunits.Bps
+		bpsOk   bool
+		wantOk  bool
+	}{
+		{in: "0.3%", want: "0.3%", wantBps: 30, bpsOk: true, wantOk: true},
+		{in: "30bps", want: "0.3%", wantBps: 30, bpsOk: true, wantOk: true},
+		{in: "0.3", wantOk: false},
+		{in: "30", wantOk: false},
+		{in: "12.5 %", want: "12.5%", wantBps: 1250, bpsOk: true, wantOk: true},
+		{in: "0.5bps", want: "0.005%", bpsOk: false, wantOk: true},
+		{in: "0%", want: "0%", wantBps: 0, bpsOk: true, wantOk: true},
+		{in: "-1%", wantOk: false},
+		{in: "1/3%", wantOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, gotOk := ethunits.ParsePercent(tt.in)
+			assert.Equal(t, tt.wantOk, gotOk)
+			if !tt.wantOk {
+				return
+			}
+
+			assert.Equal(t, tt.want, got.String())
+
+			bps, ok := got.Bps()
+			assert.Equal(t, tt.bpsOk, ok)
+			assert.Equal(t, tt.wantBps, bps)
+		})
+	}
+
+	third, ok := ethunits.NewPercent(1, 3)
+	require.True(t, ok)
+	assert.Equal(t, "0.333333333333333333%", third.String())
+
+	// num/den percent doesn't overflow for large denominators.
+	tiny, ok := ethunits.NewPercent(1, math.MaxInt64)
+	require.True(t, ok)
+	assert.Equal(t, new(big.Rat).SetFrac(big.NewInt(1), new(big.Int).Mul(big.NewInt(math.MaxInt64), big.NewInt(100))), tiny.Rat())
+
+	_, ok = ethunits.NewPercent(1, 0)
+	assert.False(t, ok)
+	assert.Equal(t, "0.3%", ethunits.Bps(30).Percent().String())
+}
+
+func TestPercent_Of(t *testing.T) {
+	tests := []struct {
+		name     string
+		percent  string
+		amount   *big.Int
+		rounding ethunits.Rounding
+		want     *big.Int
+	}{
+		{name: "30bps of ether", percent: "30bps", amount: makeBigInt(wantWeiStr), rounding: ethunits.RoundFloor, want: makeBigInt("1027500000000000000")},
+		{name: "floor", percent: "0.3%", amount: big.NewInt(999), rounding: ethunits.RoundFloor, want: big.NewInt(2)},
+		{name: "ceil", percent: "0.3%", amount: big.NewInt(999), rounding: ethunits.RoundCeil, want: big.NewInt(3)},
+		{name: "third", percent: "33.333333333333333333333333333333%", amount: big.NewInt(300), rounding: ethunits.RoundCeil, want: big.NewInt(100)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mustPercent(t, tt.percent).Of(tt.amount, tt.rounding)
+			require.NoError(t, err)
+			assertBigIntEqual(t, tt.want, got)
+		})
+	}
+}
+
+func TestPercent_DeductGrossUp(t *testing.T) {
+	fee := ethunits.Bps(30)
+
+	net, err := fee.Deduct(big.NewInt(1000), ethunits.RoundFloor)
+	require.NoError(t, err)
+	assertBigIntEqual(t, big.NewInt(997), net)
+
+	gross, err := fee.GrossUp(big.NewInt(997), ethunits.RoundCeil)
+	require.NoError(t, err)
+	assertBigIntEqual(t, big.NewInt(1000), gross)
+
+	gross, err = fee.GrossUp(big.NewInt(1000), ethunits.RoundCeil)
+	require.NoError(t, err)
+	assertBigIntEqual(t, big.NewInt(1004), gross)
+
+	for _, amount := range []int64{1, 7, 999, 1000, 123456789} {
+		gross, err := fee.GrossUp(big.NewInt(amount), ethunits.RoundCeil)
+		require.NoError(t, err)
+
+		net, err := fee.Deduct(gross, ethunits.RoundFloor)
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, net.Int64(), amount)
+	}
+
+	_, err = mustPercent(t, "100%").GrossUp(big.NewInt(1), ethunits.RoundCeil)
+	assert.ErrorIs(t, err, ethunits.ErrInvalidPercent)
+
+	_, err = mustPercent(t, "101%").Deduct(big.NewInt(1), ethunits.RoundCeil)
+	assert.ErrorIs(t, err, ethunits.ErrInvalidPercent)
+}
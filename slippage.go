@@ -0,0 +1,50 @@
+package ethunits
+
+import (
+	"errors"
+	"math/big"
+)
+
+var ErrInvalidTolerance = errors.New("invalid slippage tolerance")
+
+var bigMaxBps = MaxBps.bigInt()
+
+// MinimumReceived returns the amountOutMin to submit with a swap quoted to
+// yield amountOut, given a slippage tolerance.
+// The result is rounded up, so it never accepts less than the tolerance allows.
+func MinimumReceived(amountOut *big.Int, tolerance Bps) (*big.Int, error) {
+	if tolerance > MaxBps {
+		return nil, ErrInvalidTolerance
+	}
+
+	return MulDiv(amountOut, (MaxBps - tolerance).bigInt(), bigMaxBps, RoundCeil)
+}
+
+// MaximumSent returns the amountInMax to submit with a swap quoted to
+// cost amountIn, given a slippage tolerance.
+// The result is rounded down, so it never pays more than the tolerance allows.
+func MaximumSent(amountIn *big.Int, tolerance Bps) (*big.Int, error) {
+	return MulDiv(amountIn, new(big.Int).Add(bigMaxBps, tolerance.bigInt()), bigMaxBps, RoundFloor)
+}
+
+// MinimumReceivedString is like MinimumReceived, but parses the tolerance
+// with ParseBps, e.g. "0.5%" or "50bps".
+func MinimumReceivedString(amountOut *big.Int, tolerance string) (*big.Int, error) {
+	bps, ok := ParseBps(tolerance)
+	if !ok {
+		return nil, ErrInvalidTolerance
+	}
+
+	return MinimumReceived(amountOut, bps)
+}
+
+// MaximumSentString is like MaximumSent, but parses the tolerance
+// with ParseBps, e.g. "0.5%" or "50bps".
+func MaximumSentString(amountIn *big.Int, tolerance string) (*big.Int, error) {
+	bps, ok := ParseBps(tolerance)
+	if !ok {
+		return nil, ErrInvalidTolerance
+	}
+
+	return MaximumSent(amountIn, bps)
+}
@@ -0,0 +1,90 @@
+package ethunits_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jalavosus/go-ethunits"
+)
+
+func TestParseBps(t *testing.T) {
+	tests := []struct {
+		in     string
+		want   ethunits.Bps
+		wantOk bool
+	}{
+		{in: "50", want: 50, wantOk: true},
+		{in: "50bps", want: 50, wantOk: true},
+		{in: "1 bp", want: 1, wantOk: true},
+		{in: "0.5%", want: 50, wantOk: true},
+		{in: " 0.05 % ", want: 5, wantOk: true},
+		{in: "100%", want: 10000, wantOk: true},
+		{in: "0.005%", wantOk: false},
+		{in: "-1%", wantOk: false},
+		{in: "1.5bps", wantOk: false},
+		{in: "abc", wantOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, gotOk := ethunits.ParseBps(tt.in)
+			assert.Equal(t, tt.wantOk, gotOk)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMinimumReceived(t *testing.T) {
+	tests := []struct {
+		name      string
+		amount    *big.Int
+		tolerance string
+		want      *big.Int
+		wantErr   error
+	}{
+		{name: "0.5% of ether", amount: makeBigInt(wantWeiStr), tolerance: "0.5%", want: makeBigInt("340787500000000000000")},
+		{name: "rounds up", amount: big.NewInt(999), tolerance: "50bps", want: big.NewInt(995)},
+		{name: "zero tolerance", amount: big.NewInt(999), tolerance: "0", want: big.NewInt(999)},
+		{name: "full tolerance", amount: big.NewInt(999), tolerance: "100%", want: big.NewInt(0)},
+		{name: "tolerance above 100%", amount: big.NewInt(999), tolerance: "101%", wantErr: ethunits.ErrInvalidTolerance},
+		{name: "malformed tolerance", amount: big.NewInt(999), tolerance: "lots", wantErr: ethunits.ErrInvalidTolerance},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ethunits.MinimumReceivedString(tt.amount, tt.tolerance)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assertBigIntEqual(t, tt.want, got)
+		})
+	}
+}
+
+func TestMaximumSent(t *testing.T) {
+	tests := []struct {
+		name      string
+		amount    *big.Int
+		tolerance ethunits.Bps
+		want      *big.Int
+	}{
+		{name: "0.5% of ether", amount: makeBigInt(wantWeiStr), tolerance: 50, want: makeBigInt("344212500000000000000")},
+		{name: "rounds down", amount: big.NewInt(999), tolerance: 50, want: big.NewInt(1003)},
+		{name: "zero tolerance", amount: big.NewInt(999), tolerance: 0, want: big.NewInt(999)},
+		{name: "200%", amount: big.NewInt(999), tolerance: 20000, want: big.NewInt(2997)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ethunits.MaximumSent(tt.amount, tt.tolerance)
+			require.NoError(t, err)
+			assertBigIntEqual(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+//go:build go1.21
+
+package ethunits
+
+import (
+	"log/slog"
+	"math/big"
+)
+
+// LogValue renders u as its lowercase name, e.g. "gwei".
+func (u Unit) LogValue() slog.Value {
+	if _, ok := u.WeiExponent(); !ok {
+		return slog.StringValue(u.String())
+	}
+
+	return slog.StringValue(unitName(u))
+}
+
+// LogValue renders a as a group of its exact amount of Wei, its decimal
+// amount in its unit and the unit, e.g.
+// value.wei=1500000000000000000 value.display=1.5 value.unit=ether.
+// Amounts are rendered as strings, so that no handler loses precision.
+func (a Amount) LogValue() slog.Value {
+	wei := a.Wei
+	if wei == nil {
+		wei = new(big.Int)
+	}
+
+	display, ok := FormatWei(wei, a.Unit)
+	if !ok {
+		display = wei.String()
+	}
+
+	return slog.GroupValue(
+		slog.String("wei", wei.String()),
+		slog.String("display", display),
+		slog.Any("unit", a.Unit),
+	)
+}
+
+// Attr returns a slog attribute for an amount of Wei, displayed in Ether.
+// The amount is only formatted if the record is logged.
+func Attr(key string, wei *big.Int) slog.Attr {
+	return AttrIn(key, wei, Ether)
+}
+
+// AttrIn returns a slog attribute for an amount of Wei, displayed in u.
+func AttrIn(key string, wei *big.Int, u Unit) slog.Attr {
+	return slog.Any(key, Amount{Wei: wei, Unit: u})
+}
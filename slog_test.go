@@ -0,0 +1,49 @@
+//go:build go1.21
+
+package ethunits_test
+
+import (
+	"bytes"
+	"log/slog"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jalavosus/go-ethunits"
+)
+
+func TestLogValue(t *testing.T) {
+	var buf bytes.Buffer
+
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+
+	logger.Info("transfer",
+		ethunits.Attr("value", makeBigInt(wantWeiStr)),
+		ethunits.AttrIn("fee", big.NewInt(21000*30000000000), ethunits.GWei),
+		slog.Any("unit", ethunits.Szabo),
+		slog.Any("balance", ethunits.Amount{}),
+	)
+
+	assert.Equal(t,
+		"level=INFO msg=transfer"+
+			" value.wei="+wantWeiStr+" value.display="+wantEtherStr+" value.unit=ether"+
+			" fee.wei=630000000000000 fee.display=630000 fee.unit=gwei"+
+			" unit=szabo"+
+			" balance.wei=0 balance.display=0 balance.unit=wei\n",
+		buf.String(),
+	)
+
+	buf.Reset()
+	slog.New(slog.NewJSONHandler(&buf, nil)).Info("transfer", ethunits.Attr("value", big.NewInt(1)))
+	assert.Contains(t, buf.String(), `"value":{"wei":"1","display":"0.000000000000000001","unit":"ether"}`)
+
+	assert.Equal(t, "Unknown", ethunits.Unknown.LogValue().String())
+}
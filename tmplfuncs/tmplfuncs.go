@@ -0,0 +1,240 @@
+// Package tmplfuncs provides template functions which format amounts of
+// ether and tokens, for use with text/template and html/template.
+//
+// Every function accepts amounts as *big.Int, big.Int, ethunits.Amount,
+// Go integers, or strings holding a decimal or 0x-prefixed hexadecimal number
+// of wei or an amount with a unit such as "1.5 ether". Invalid arguments
+// make the function return an error, which aborts template execution.
+//
+//	{{ toEther .Balance }} ETH         → 1.5 ETH
+//	{{ toGwei .GasPrice }} gwei        → 30 gwei
+//	{{ formatUnits .Raw .Decimals }}   → 1234.56789
+//	{{ formatAuto .Value }}            → 30 gwei
+//	{{ fiat .Balance .EthUSD }}        → 4685.18 USD
+//	{{ shortAmount .Balance }}         → 1.2346 ETH
+//	{{ compactAmount .Balance }}       → 1.23 ETH
+package tmplfuncs
+
+import (
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"math/big"
+	"reflect"
+	"strings"
+	"text/template"
+
+	"github.com/jalavosus/go-ethunits"
+	"github.com/jalavosus/go-ethunits/fiat"
+)
+
+var errNilAmount = errors.New("nil amount")
+
+// shortDecimals is the number of decimal places shown by shortAmount.
+const shortDecimals = 4
+
+// compactOptions configures the compact notation used by compactAmount.
+var compactOptions = &ethunits.CompactOptions{MinValue: big.NewRat(1, 10000)}
+
+// FuncMap returns the functions for use with text/template.
+func FuncMap() template.FuncMap {
+	return template.FuncMap{
+		"toEther":       ToEther,
+		"toGwei":        ToGwei,
+		"formatUnits":   FormatUnits,
+		"formatAuto":    FormatAuto,
+		"fiat":          Fiat,
+		"shortAmount":   ShortAmount,
+		"compactAmount": CompactAmount,
+	}
+}
+
+// HTMLFuncMap returns the functions for use with html/template.
+func HTMLFuncMap() htmltemplate.FuncMap {
+	return htmltemplate.FuncMap(FuncMap())
+}
+
+// ToEther renders an amount of wei as a decimal amount of ether.
+func ToEther(amount any) (string, error) {
+	return formatIn("toEther", amount, ethunits.Ether)
+}
+
+// ToGwei renders an amount of wei as a decimal amount of gwei.
+func ToGwei(amount any) (string, error) {
+	return formatIn("toGwei", amount, ethunits.GWei)
+}
+
+// FormatUnits renders a raw amount of a token with the given decimals.
+func FormatUnits(amount, decimals any) (string, error) {
+	raw, err := toBigInt(amount)
+	if err != nil {
+		return "", fmt.Errorf("formatUnits: %w", err)
+	}
+
+	d, err := toDecimals(decimals)
+	if err != nil {
+		return "", fmt.Errorf("formatUnits: %w", err)
+	}
+
+	return ethunits.FormatUnits(raw, d), nil
+}
+
+// FormatAuto renders an amount of wei in the largest of ether, gwei and wei
+// in which it is at least 1, followed by the unit, e.g. "30 gwei".
+func FormatAuto(amount any) (string, error) {
+	wei, err := toBigInt(amount)
+	if err != nil {
+		return "", fmt.Errorf("formatAuto: %w", err)
+	}
+
+	abs := new(big.Int).Abs(wei)
+	for _, u := range []ethunits.Unit{ethunits.Ether, ethunits.GWei} {
+		exp, _ := u.WeiExponent()
+		if abs.Cmp(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exp)), nil)) >= 0 {
+			return ethunits.FormatUnits(wei, exp) + " " + strings.ToLower(u.String()), nil
+		}
+	}
+
+	return wei.String() + " wei", nil
+}
+
+// Fiat converts an amount at a fiat.Quote, rounded to the currency's minor
+// units, e.g. "4685.18 USD". The amount is in wei unless the decimals of the
+// quoted asset are given.
+func Fiat(amount any, quote any, decimals ...any) (string, error) {
+	raw, err := toBigInt(amount)
+	if err != nil {
+		return "", fmt.Errorf("fiat: %w", err)
+	}
+
+	var q fiat.Quote
+	switch x := quote.(type) {
+	case fiat.Quote:
+		q = x
+	case *fiat.Quote:
+		if x == nil {
+			return "", errors.New("fiat: nil quote")
+		}
+		q = *x
+	default:
+		return "", fmt.Errorf("fiat: want a fiat.Quote, got %T", quote)
+	}
+
+	var d uint8 = 18
+	switch len(decimals) {
+	case 0:
+	case 1:
+		if d, err = toDecimals(decimals[0]); err != nil {
+			return "", fmt.Errorf("fiat: %w", err)
+		}
+	default:
+		return "", fmt.Errorf("fiat: want at most 3 arguments, got %d", 2+len(decimals))
+	}
+
+	a, err := fiat.Convert(raw, d, q)
+	if err != nil {
+		return "", err
+	}
+
+	return a.String(), nil
+}
+
+// ShortAmount renders an amount of wei in ether rounded half up to
+// 4 decimal places, e.g. "1.2346 ETH". Non-zero amounts too small to show
+// are rendered as "<0.0001 ETH".
+func ShortAmount(amount any) (string, error) {
+	wei, err := toBigInt(amount)
+	if err != nil {
+		return "", fmt.Errorf("shortAmount: %w", err)
+	}
+
+	unit := new(big.Int).Exp(big.NewInt(10), big.NewInt(18-shortDecimals), nil)
+
+	q, r := new(big.Int).QuoRem(new(big.Int).Abs(wei), unit, new(big.Int))
+	if r.Lsh(r, 1).Cmp(unit) >= 0 {
+		q.Add(q, big.NewInt(1))
+	}
+
+	if q.Sign() == 0 && wei.Sign() != 0 {
+		if wei.Sign() < 0 {
+			return ">-0.0001 ETH", nil
+		}
+		return "<0.0001 ETH", nil
+	}
+
+	if wei.Sign() < 0 {
+		q.Neg(q)
+	}
+
+	return ethunits.FormatUnits(q, shortDecimals) + " ETH", nil
+}
+
+// CompactAmount renders an amount of wei in ether in compact notation, with
+// 3 significant digits, e.g. "1.23 ETH" or "4.56M ETH". Non-zero amounts
+// below 0.0001 ETH are rendered as "<0.0001 ETH".
+func CompactAmount(amount any) (string, error) {
+	wei, err := toBigInt(amount)
+	if err != nil {
+		return "", fmt.Errorf("compactAmount: %w", err)
+	}
+
+	s, _ := ethunits.FormatCompact(wei, ethunits.Ether, compactOptions)
+
+	return s, nil
+}
+
+func formatIn(name string, amount any, u ethunits.Unit) (string, error) {
+	wei, err := toBigInt(amount)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", name, err)
+	}
+
+	s, _ := ethunits.FormatWei(wei, u)
+
+	return s, nil
+}
+
+// toBigInt converts a template argument into an integer amount.
+func toBigInt(v any) (*big.Int, error) {
+	switch x := v.(type) {
+	case *big.Int:
+		if x == nil {
+			return nil, errNilAmount
+		}
+		return x, nil
+	case big.Int:
+		return &x, nil
+	case ethunits.Amount:
+		return toBigInt(x.Wei)
+	case *ethunits.Amount:
+		if x == nil {
+			return nil, errNilAmount
+		}
+		return toBigInt(x.Wei)
+	case string:
+		wei, ok := ethunits.ParseAmount(x, ethunits.Wei)
+		if !ok {
+			return nil, fmt.Errorf("invalid amount %q", x)
+		}
+		return wei, nil
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return big.NewInt(rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return new(big.Int).SetUint64(rv.Uint()), nil
+	}
+
+	return nil, fmt.Errorf("can't use %T as an amount", v)
+}
+
+func toDecimals(v any) (uint8, error) {
+	d, err := toBigInt(v)
+	if err != nil || !d.IsUint64() || d.Uint64() > 255 {
+		return 0, fmt.Errorf("invalid decimals %v", v)
+	}
+
+	return uint8(d.Uint64()), nil
+}
@@ -0,0 +1,118 @@
+package tmplfuncs_test
+
+import (
+	htmltemplate "html/template"
+	"math/big"
+	"strings"
+	"testing"
+	"text/template"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jalavosus/go-ethunits"
+	"github.com/jalavosus/go-ethunits/fiat"
+	"github.com/jalavosus/go-ethunits/tmplfuncs"
+)
+
+func execute(t *testing.T, text string, data any) (string, error) {
+	t.Helper()
+
+	tmpl, err := template.New("test").Funcs(tmplfuncs.FuncMap()).Parse(text)
+	require.NoError(t, err)
+
+	var b strings.Builder
+	err = tmpl.Execute(&b, data)
+
+	return b.String(), err
+}
+
+func TestFuncs(t *testing.T) {
+	balance, _ := new(big.Int).SetString("1234567890000000000", 10)
+
+	ethUSD, err := fiat.NewQuote("ETH", "USD", "3123.456", time.Time{})
+	require.NoError(t, err)
+
+	usdcEUR, err := fiat.NewQuote("USDC", "EUR", "0.9215", time.Time{})
+	require.NoError(t, err)
+
+	data := map[string]any{
+		"Balance":  balance,
+		"GasPrice": "0x6fc23ac00",
+		"Raw":      big.NewInt(1234567891),
+		"Decimals": uint8(6),
+		"Amount":   ethunits.Amount{Wei: big.NewInt(42), Unit: ethunits.Wei},
+		"Tiny":     big.NewInt(1000),
+		"Negative": big.NewInt(-1500000000000000000),
+		"EthUSD":   ethUSD,
+		"UsdcEUR":  &usdcEUR,
+	}
+
+	tests := []struct {
+		text string
+		want string
+	}{
+		{text: "{{ toEther .Balance }}", want: "1.23456789"},
+		{text: "{{ toEther \"1.5 ether\" }}", want: "1.5"},
+		{text: "{{ toGwei .GasPrice }}", want: "30"},
+		{text: "{{ toGwei 21000 }}", want: "0.000021"},
+		{text: "{{ formatUnits .Raw .Decimals }}", want: "1234.567891"},
+		{text: "{{ formatUnits .Raw 8 }}", want: "12.34567891"},
+		{text: "{{ formatAuto .Balance }}", want: "1.23456789 ether"},
+		{text: "{{ formatAuto .GasPrice }}", want: "30 gwei"},
+		{text: "{{ formatAuto .Amount }}", want: "42 wei"},
+		{text: "{{ formatAuto .Negative }}", want: "-1.5 ether"},
+		{text: "{{ fiat .Balance .EthUSD }}", want: "3856.12 USD"},
+		{text: "{{ fiat .Raw .UsdcEUR .Decimals }}", want: "1137.65 EUR"},
+		{text: "{{ shortAmount .Balance }}", want: "1.2346 ETH"},
+		{text: "{{ shortAmount .Tiny }}", want: "<0.0001 ETH"},
+		{text: "{{ shortAmount .Negative }}", want: "-1.5 ETH"},
+		{text: "{{ shortAmount 0 }}", want: "0 ETH"},
+		{text: "{{ compactAmount .Balance }}", want: "1.23 ETH"},
+		{text: "{{ compactAmount \"1234567 ether\" }}", want: "1.23M ETH"},
+		{text: "{{ compactAmount .Tiny }}", want: "<0.0001 ETH"},
+		{text: "{{ compactAmount .Negative }}", want: "-1.5 ETH"},
+		{text: "{{ compactAmount 0 }}", want: "0 ETH"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			got, err := execute(t, tt.text, data)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFuncErrors(t *testing.T) {
+	tests := []struct {
+		text    string
+		wantErr string
+	}{
+		{text: "{{ toEther \"lots\" }}", wantErr: `toEther: invalid amount "lots"`},
+		{text: "{{ toGwei .Missing }}", wantErr: "toGwei: can't use <nil> as an amount"},
+		{text: "{{ toEther .Nil }}", wantErr: "toEther: nil amount"},
+		{text: "{{ toEther 1.5 }}", wantErr: "toEther: can't use float64 as an amount"},
+		{text: "{{ formatUnits 1 300 }}", wantErr: "formatUnits: invalid decimals 300"},
+		{text: "{{ fiat 1 \"ETH\" }}", wantErr: "fiat: want a fiat.Quote, got string"},
+	}
+
+	data := map[string]any{"Nil": (*big.Int)(nil)}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			_, err := execute(t, tt.text, data)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestHTMLFuncMap(t *testing.T) {
+	tmpl, err := htmltemplate.New("test").Funcs(tmplfuncs.HTMLFuncMap()).Parse(`<td>{{ shortAmount .Tiny }}</td>`)
+	require.NoError(t, err)
+
+	var b strings.Builder
+	require.NoError(t, tmpl.Execute(&b, map[string]any{"Tiny": big.NewInt(1)}))
+	assert.Equal(t, "<td>&lt;0.0001 ETH</td>", b.String())
+}
@@ -0,0 +1,146 @@
+package ethunits
+
+import (
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Token describes a fungible token deployed at an address on a specific chain.
+type Token struct {
+	ChainID  uint64
+	Address  string
+	Symbol   string
+	Name     string
+	Decimals uint8
+	Tags     []string
+}
+
+// Unit returns the built-in Unit whose scale matches the token's decimals,
+// if there is one: Ether for 18 decimals, GWei for 9 and Wei for 0.
+func (t Token) Unit() (Unit, bool) {
+	for _, u := range Units() {
+		if exp, _ := u.WeiExponent(); exp == int(t.Decimals) {
+			return u, true
+		}
+	}
+
+	return Unknown, false
+}
+
+// Format renders a raw amount of the token as an exact decimal string.
+func (t Token) Format(raw *big.Int) string {
+	return FormatUnits(raw, t.Decimals)
+}
+
+// Parse parses a decimal string into a raw amount of the token.
+func (t Token) Parse(amount string) (*big.Int, bool) {
+	return ParseUnits(amount, t.Decimals)
+}
+
+// ToDisplay converts a raw amount of the token into its
+// user-facing representation, the same way ToEther does for Wei.
+func (t Token) ToDisplay(raw *big.Int) *big.Float {
+	f := new(big.Float).SetInt(raw)
+	return f.Quo(f, new(big.Float).SetInt(pow10Int(int(t.Decimals))))
+}
+
+type tokenKey struct {
+	chainID uint64
+	address string
+}
+
+func makeTokenKey(chainID uint64, address string) tokenKey {
+	return tokenKey{chainID: chainID, address: strings.ToLower(address)}
+}
+
+// TokenRegistry holds token metadata keyed by chain ID and address.
+// It is safe for concurrent use.
+type TokenRegistry struct {
+	mu     sync.RWMutex
+	tokens map[tokenKey]Token
+}
+
+// NewTokenRegistry returns an empty TokenRegistry.
+func NewTokenRegistry() *TokenRegistry {
+	return &TokenRegistry{tokens: make(map[tokenKey]Token)}
+}
+
+// Add adds a token to the registry, replacing any token previously
+// registered with the same chain ID and address.
+func (r *TokenRegistry) Add(t Token) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.tokens[makeTokenKey(t.ChainID, t.Address)] = t
+}
+
+// Lookup returns the token registered at the given address on the given chain.
+// Address comparison is case-insensitive.
+func (r *TokenRegistry) Lookup(chainID uint64, address string) (Token, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	t, ok := r.tokens[makeTokenKey(chainID, address)]
+	return t, ok
+}
+
+// LookupSymbol returns the first token, ordered by address, registered with
+// the given symbol on the given chain.
+func (r *TokenRegistry) LookupSymbol(chainID uint64, symbol string) (Token, bool) {
+	for _, t := range r.ChainTokens(chainID) {
+		if t.Symbol == symbol {
+			return t, true
+		}
+	}
+
+	return Token{}, false
+}
+
+// Decimals returns the decimals of the token registered at the given address
+// on the given chain.
+func (r *TokenRegistry) Decimals(chainID uint64, address string) (uint8, bool) {
+	t, ok := r.Lookup(chainID, address)
+	return t.Decimals, ok
+}
+
+// Len returns the number of registered tokens.
+func (r *TokenRegistry) Len() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return len(r.tokens)
+}
+
+// Tokens returns every registered token, ordered by chain ID and address.
+func (r *TokenRegistry) Tokens() []Token {
+	return r.filter(func(Token) bool { return true })
+}
+
+// ChainTokens returns every token registered on the given chain, ordered by address.
+func (r *TokenRegistry) ChainTokens(chainID uint64) []Token {
+	return r.filter(func(t Token) bool { return t.ChainID == chainID })
+}
+
+func (r *TokenRegistry) filter(keep func(Token) bool) []Token {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var tokens []Token
+	for _, t := range r.tokens {
+		if keep(t) {
+			tokens = append(tokens, t)
+		}
+	}
+
+	sort.Slice(tokens, func(i, j int) bool {
+		if tokens[i].ChainID != tokens[j].ChainID {
+			return tokens[i].ChainID < tokens[j].ChainID
+		}
+
+		return strings.ToLower(tokens[i].Address) < strings.ToLower(tokens[j].Address)
+	})
+
+	return tokens
+}
@@ -0,0 +1,139 @@
+package ethunits
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+)
+
+var (
+	ErrInvalidChainID  = errors.New("chainId must be a positive integer")
+	ErrInvalidAddress  = errors.New("address must be a 0x-prefixed 20 byte hex string")
+	ErrInvalidChecksum = errors.New("address is not EIP-55 checksummed")
+	ErrInvalidDecimals = errors.New("decimals must be an integer between 0 and 255")
+	ErrInvalidSymbol   = errors.New("symbol must be between 1 and 20 characters")
+	ErrInvalidName     = errors.New("name must be between 1 and 60 characters")
+	ErrUnknownTag      = errors.New("tag is not defined by the token list")
+	ErrDuplicateToken  = errors.New("token address is already registered on this chain")
+)
+
+var addressPattern = regexp.MustCompile(`^0x[0-9a-fA-F]{40}$`)
+
+// TokenListError describes why an entry of a token list was rejected.
+type TokenListError struct {
+	// Index is the position of the entry in the list's tokens array.
+	Index   int
+	ChainID uint64
+	Address string
+	Symbol  string
+	Err     error
+}
+
+func (e TokenListError) Error() string {
+	return fmt.Sprintf("tokens[%d] (chainId %d, %s %s): %s", e.Index, e.ChainID, e.Symbol, e.Address, e.Err)
+}
+
+func (e TokenListError) Unwrap() error {
+	return e.Err
+}
+
+type tokenList struct {
+	Name   string                     `json:"name"`
+	Tags   map[string]json.RawMessage `json:"tags"`
+	Tokens []tokenListEntry           `json:"tokens"`
+}
+
+type tokenListEntry struct {
+	ChainID  json.Number `json:"chainId"`
+	Address  string      `json:"address"`
+	Symbol   string      `json:"symbol"`
+	Name     string      `json:"name"`
+	Decimals json.Number `json:"decimals"`
+	Tags     []string    `json:"tags"`
+}
+
+// LoadTokenList reads a Uniswap-style token list (https://tokenlists.org) from rd
+// and adds every valid entry to the registry.
+// Entries which fail validation, including entries whose chain ID and address
+// are already registered, are skipped and reported in the returned slice.
+// The error is only non-nil if rd can't be decoded as a token list at all.
+func (r *TokenRegistry) LoadTokenList(rd io.Reader) ([]TokenListError, error) {
+	var list tokenList
+
+	dec := json.NewDecoder(rd)
+	dec.UseNumber()
+	if err := dec.Decode(&list); err != nil {
+		return nil, fmt.Errorf("decoding token list: %w", err)
+	}
+
+	var report []TokenListError
+	for i, entry := range list.Tokens {
+		t, err := entry.token(list.Tags)
+		if err == nil {
+			r.mu.Lock()
+			key := makeTokenKey(t.ChainID, t.Address)
+			if _, exists := r.tokens[key]; exists {
+				err = ErrDuplicateToken
+			} else {
+				r.tokens[key] = t
+			}
+			r.mu.Unlock()
+		}
+
+		if err != nil {
+			report = append(report, TokenListError{
+				Index:   i,
+				ChainID: t.ChainID,
+				Address: entry.Address,
+				Symbol:  entry.Symbol,
+				Err:     err,
+			})
+		}
+	}
+
+	return report, nil
+}
+
+func (e tokenListEntry) token(definedTags map[string]json.RawMessage) (t Token, err error) {
+	chainID, chainErr := strconv.ParseUint(e.ChainID.String(), 10, 64)
+	if chainErr == nil {
+		t.ChainID = chainID
+	}
+
+	switch {
+	case chainErr != nil || chainID == 0:
+		return t, ErrInvalidChainID
+	case !addressPattern.MatchString(e.Address):
+		return t, ErrInvalidAddress
+	case !IsChecksumAddress(e.Address):
+		return t, ErrInvalidChecksum
+	case len(e.Symbol) == 0 || len(e.Symbol) > 20:
+		return t, ErrInvalidSymbol
+	case len(e.Name) == 0 || len(e.Name) > 60:
+		return t, ErrInvalidName
+	}
+
+	decimals, err := strconv.ParseUint(e.Decimals.String(), 10, 8)
+	if err != nil {
+		return t, ErrInvalidDecimals
+	}
+
+	if definedTags != nil {
+		for _, tag := range e.Tags {
+			if _, ok := definedTags[tag]; !ok {
+				return t, fmt.Errorf("%w: %q", ErrUnknownTag, tag)
+			}
+		}
+	}
+
+	t.Address = e.Address
+	t.Symbol = e.Symbol
+	t.Name = e.Name
+	t.Decimals = uint8(decimals)
+	t.Tags = e.Tags
+
+	return t, nil
+}
@@ -0,0 +1,115 @@
+package ethunits_test
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jalavosus/go-ethunits"
+)
+
+func TestChecksumAddress(t *testing.T) {
+	tests := []string{
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+		"0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB",
+		"0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb",
+	}
+
+	for _, want := range tests {
+		t.Run(want, func(t *testing.T) {
+			got, ok := ethunits.ChecksumAddress(strings.ToLower(want))
+			assert.True(t, ok)
+			assert.Equal(t, want, got)
+			assert.True(t, ethunits.IsChecksumAddress(want))
+			assert.False(t, ethunits.IsChecksumAddress(strings.ToLower(want)))
+		})
+	}
+
+	_, ok := ethunits.ChecksumAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeZ")
+	assert.False(t, ok)
+}
+
+func TestToken_Unit(t *testing.T) {
+	tests := []struct {
+		decimals uint8
+		want     ethunits.Unit
+		wantOk   bool
+	}{
+		{decimals: 18, want: ethunits.Ether, wantOk: true},
+		{decimals: 9, want: ethunits.GWei, wantOk: true},
+		{decimals: 6, want: ethunits.MWei, wantOk: true},
+		{decimals: 0, want: ethunits.Wei, wantOk: true},
+		{decimals: 8, want: ethunits.Unknown, wantOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(strconv.Itoa(int(tt.decimals)), func(t *testing.T) {
+			got, ok := ethunits.Token{Decimals: tt.decimals}.Unit()
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTokenRegistry_LoadTokenList(t *testing.T) {
+	f, err := os.Open("testdata/tokenlist.json")
+	require.NoError(t, err)
+	defer f.Close()
+
+	registry := ethunits.NewTokenRegistry()
+	report, err := registry.LoadTokenList(f)
+	require.NoError(t, err)
+
+	assert.Equal(t, 4, registry.Len())
+
+	wantErrs := map[int]error{
+		4:  ethunits.ErrInvalidChecksum,
+		5:  ethunits.ErrInvalidDecimals,
+		6:  ethunits.ErrInvalidDecimals,
+		7:  ethunits.ErrInvalidChainID,
+		8:  ethunits.ErrInvalidAddress,
+		9:  ethunits.ErrDuplicateToken,
+		10: ethunits.ErrInvalidSymbol,
+		11: ethunits.ErrUnknownTag,
+	}
+
+	require.Len(t, report, len(wantErrs))
+	for _, entryErr := range report {
+		assert.ErrorIsf(t, entryErr, wantErrs[entryErr.Index], "tokens[%d]", entryErr.Index)
+	}
+
+	usdc, ok := registry.Lookup(1, "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48")
+	require.True(t, ok)
+	assert.Equal(t, "USDC", usdc.Symbol)
+	assert.Equal(t, []string{"stablecoin"}, usdc.Tags)
+
+	unit, ok := usdc.Unit()
+	assert.True(t, ok)
+	assert.Equal(t, ethunits.MWei, unit)
+
+	raw, ok := usdc.Parse("1234.56")
+	require.True(t, ok)
+	assert.Equal(t, "1234560000", raw.String())
+	assert.Equal(t, "1234.56", usdc.Format(raw))
+
+	decimals, ok := registry.Decimals(137, "0x2791Bca1f2de4661ED88A30C99A7a9449Aa84174")
+	assert.True(t, ok)
+	assert.Equal(t, uint8(6), decimals)
+
+	weth, ok := registry.LookupSymbol(1, "WETH")
+	require.True(t, ok)
+	assertBigFloatEqual(t, makeBigFloat(wantEtherStr), weth.ToDisplay(makeBigInt(wantWeiStr)))
+
+	assert.Len(t, registry.ChainTokens(1), 3)
+}
+
+func TestTokenRegistry_LoadTokenList_Malformed(t *testing.T) {
+	registry := ethunits.NewTokenRegistry()
+	_, err := registry.LoadTokenList(strings.NewReader(`{"tokens": {}}`))
+	assert.Error(t, err)
+}
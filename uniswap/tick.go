@@ -0,0 +1,114 @@
+package uniswap
+
+import (
+	"errors"
+	"math/big"
+	"sort"
+)
+
+const (
+	// MinTick is the minimum tick of a Uniswap v3 pool.
+	MinTick = -887272
+	// MaxTick is the maximum tick of a Uniswap v3 pool.
+	MaxTick = -MinTick
+)
+
+var (
+	ErrTickOutOfRange      = errors.New("uniswap: tick is outside [MinTick, MaxTick]")
+	ErrSqrtPriceOutOfRange = errors.New("uniswap: sqrtPriceX96 is outside [MinSqrtRatio, MaxSqrtRatio)")
+)
+
+var (
+	// MinSqrtRatio is the sqrtPriceX96 at MinTick.
+	MinSqrtRatio = big.NewInt(4295128739)
+	// MaxSqrtRatio is the sqrtPriceX96 at MaxTick.
+	MaxSqrtRatio, _ = new(big.Int).SetString("1461446703485210103287273052203988822378723970342", 10)
+)
+
+var (
+	maxUint256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	q32Mask    = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 32), big.NewInt(1))
+)
+
+// tickRatios[i] is 2^128 / sqrt(1.0001^(2^i)), as hardcoded in TickMath.sol.
+var tickRatios = func() []*big.Int {
+	hex := []string{
+		"fffcb933bd6fad37aa2d162d1a594001",
+		"fff97272373d413259a46990580e213a",
+		"fff2e50f5f656932ef12357cf3c7fdcc",
+		"ffe5caca7e10e4e61c3624eaa0941cd0",
+		"ffcb9843d60f6159c9db58835c926644",
+		"ff973b41fa98c081472e6896dfb254c0",
+		"ff2ea16466c96a3843ec78b326b52861",
+		"fe5dee046a99a2a811c461f1969c3053",
+		"fcbe86c7900a88aedcffc83b479aa3a4",
+		"f987a7253ac413176f2b074cf7815e54",
+		"f3392b0822b70005940c7a398e4b70f3",
+		"e7159475a2c29b7443b29c7fa6e889d9",
+		"d097f3bdfd2022b8845ad8f792aa5825",
+		"a9f746462d870fdf8a65dc1f90e061e5",
+		"70d869a156d2a1b890bb3df62baf32f7",
+		"31be135f97d08fd981231505542fcfa6",
+		"9aa508b5b7a84e1c677de54f3e99bc9",
+		"5d6af8dedb81196699c329225ee604",
+		"2216e584f5fa1ea926041bedfe98",
+		"48a170391f7dc42444e8fa2",
+	}
+
+	ratios := make([]*big.Int, len(hex))
+	for i, h := range hex {
+		ratios[i], _ = new(big.Int).SetString(h, 16)
+	}
+
+	return ratios
+}()
+
+// SqrtRatioAtTick returns sqrt(1.0001^tick) as a Q64.96 fixed-point number,
+// exactly like TickMath.getSqrtRatioAtTick.
+func SqrtRatioAtTick(tick int) (*big.Int, error) {
+	if tick < MinTick || tick > MaxTick {
+		return nil, ErrTickOutOfRange
+	}
+
+	absTick := tick
+	if absTick < 0 {
+		absTick = -absTick
+	}
+
+	ratio := new(big.Int).Lsh(big.NewInt(1), 128)
+	for i, r := range tickRatios {
+		if absTick&(1<<i) != 0 {
+			ratio.Mul(ratio, r)
+			ratio.Rsh(ratio, 128)
+		}
+	}
+
+	if tick > 0 {
+		ratio.Quo(maxUint256, ratio)
+	}
+
+	roundUp := new(big.Int).And(ratio, q32Mask).Sign() != 0
+	ratio.Rsh(ratio, 32)
+	if roundUp {
+		ratio.Add(ratio, big.NewInt(1))
+	}
+
+	return ratio, nil
+}
+
+// TickAtSqrtRatio returns the greatest tick whose sqrt ratio is less than or
+// equal to sqrtPriceX96, exactly like TickMath.getTickAtSqrtRatio.
+func TickAtSqrtRatio(sqrtPriceX96 *big.Int) (int, error) {
+	if sqrtPriceX96.Cmp(MinSqrtRatio) < 0 || sqrtPriceX96.Cmp(MaxSqrtRatio) >= 0 {
+		return 0, ErrSqrtPriceOutOfRange
+	}
+
+	// The first tick in [MinTick, MaxTick] whose ratio exceeds sqrtPriceX96
+	// always exists, since sqrtPriceX96 < MaxSqrtRatio.
+	above := sort.Search(MaxTick-MinTick+1, func(i int) bool {
+		ratio, _ := SqrtRatioAtTick(MinTick + i)
+		return ratio.Cmp(sqrtPriceX96) > 0
+	})
+
+	return MinTick + above - 1, nil
+}
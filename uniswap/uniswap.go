@@ -0,0 +1,69 @@
+// Package uniswap implements the price and quote math of Uniswap v2 and v3
+// pools, adjusted for the decimals of the pool's tokens.
+package uniswap
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/jalavosus/go-ethunits"
+)
+
+var ErrInvalidPrice = errors.New("uniswap: price must be positive")
+
+// Pair describes the two tokens of a pool, in the order the pool sorts them.
+// Only the Symbol and Decimals of each token are used.
+type Pair struct {
+	Token0 ethunits.Token
+	Token1 ethunits.Token
+}
+
+// decimalsScale returns 10^(decimals0 - decimals1), which converts a price
+// in raw units into a price in display units.
+func (p Pair) decimalsScale() *big.Rat {
+	return decimalsScale(p.Token0.Decimals, p.Token1.Decimals)
+}
+
+// FormatPrice renders a price of Token0 denominated in Token1 with prec
+// decimal places, e.g. "1 WETH = 3123.45 USDC".
+func (p Pair) FormatPrice(price *big.Rat, prec int) string {
+	return "1 " + p.Token0.Symbol + " = " + price.FloatString(prec) + " " + p.Token1.Symbol
+}
+
+// FormatInversePrice renders a price of Token1 denominated in Token0 with prec
+// decimal places, e.g. "1 USDC = 0.00032 WETH".
+func (p Pair) FormatInversePrice(price *big.Rat, prec int) string {
+	return "1 " + p.Token1.Symbol + " = " + price.FloatString(prec) + " " + p.Token0.Symbol
+}
+
+// Invert returns the inverse of a price, turning a price of Token0 in Token1
+// into a price of Token1 in Token0 and vice versa.
+func Invert(price *big.Rat) (*big.Rat, error) {
+	if price.Sign() <= 0 {
+		return nil, ErrInvalidPrice
+	}
+
+	return new(big.Rat).Inv(price), nil
+}
+
+// decimalsScale returns 10^(base - quote), which converts a price of a token
+// with base decimals denominated in a token with quote decimals from raw units
+// into display units.
+func decimalsScale(base, quote uint8) *big.Rat {
+	exp := int64(base) - int64(quote)
+
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(abs(exp)), nil)
+	if exp < 0 {
+		return new(big.Rat).SetFrac(big.NewInt(1), scale)
+	}
+
+	return new(big.Rat).SetInt(scale)
+}
+
+func abs(x int64) int64 {
+	if x < 0 {
+		return -x
+	}
+
+	return x
+}
@@ -0,0 +1,169 @@
+package uniswap
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/jalavosus/go-ethunits"
+)
+
+var (
+	ErrInsufficientInputAmount  = errors.New("uniswap: insufficient input amount")
+	ErrInsufficientOutputAmount = errors.New("uniswap: insufficient output amount")
+	ErrInsufficientLiquidity    = errors.New("uniswap: insufficient liquidity")
+)
+
+var (
+	feeNumerator   = big.NewInt(997)
+	feeDenominator = big.NewInt(1000)
+)
+
+// GetAmountOut returns the maximum output amount of a swap of amountIn against
+// the given reserves, after the 0.3% fee, exactly like UniswapV2Library.getAmountOut.
+// All amounts are raw token units.
+func GetAmountOut(amountIn, reserveIn, reserveOut *big.Int) (*big.Int, error) {
+	if amountIn.Sign() <= 0 {
+		return nil, ErrInsufficientInputAmount
+	}
+
+	if reserveIn.Sign() <= 0 || reserveOut.Sign() <= 0 {
+		return nil, ErrInsufficientLiquidity
+	}
+
+	amountInWithFee := new(big.Int).Mul(amountIn, feeNumerator)
+	numerator := new(big.Int).Mul(amountInWithFee, reserveOut)
+	denominator := new(big.Int).Mul(reserveIn, feeDenominator)
+	denominator.Add(denominator, amountInWithFee)
+
+	return numerator.Quo(numerator, denominator), nil
+}
+
+// GetAmountIn returns the minimum input amount of a swap which yields amountOut
+// from the given reserves, after the 0.3% fee, exactly like UniswapV2Library.getAmountIn.
+// All amounts are raw token units.
+func GetAmountIn(amountOut, reserveIn, reserveOut *big.Int) (*big.Int, error) {
+	if amountOut.Sign() <= 0 {
+		return nil, ErrInsufficientOutputAmount
+	}
+
+	if reserveIn.Sign() <= 0 || reserveOut.Cmp(amountOut) <= 0 {
+		return nil, ErrInsufficientLiquidity
+	}
+
+	numerator := new(big.Int).Mul(reserveIn, amountOut)
+	numerator.Mul(numerator, feeDenominator)
+	denominator := new(big.Int).Sub(reserveOut, amountOut)
+	denominator.Mul(denominator, feeNumerator)
+
+	amountIn := numerator.Quo(numerator, denominator)
+
+	return amountIn.Add(amountIn, big.NewInt(1)), nil
+}
+
+// V2Pool is a snapshot of a Uniswap v2 pair, with reserves in raw token units
+// as returned by getReserves().
+type V2Pool struct {
+	Pair
+	Reserve0 *big.Int
+	Reserve1 *big.Int
+}
+
+// V2Quote describes a swap against a V2Pool.
+type V2Quote struct {
+	TokenIn   ethunits.Token
+	TokenOut  ethunits.Token
+	AmountIn  *big.Int
+	AmountOut *big.Int
+	// SpotPrice is the pool's price of TokenIn denominated in TokenOut
+	// before the swap, in display units.
+	SpotPrice *big.Rat
+	// ExecutionPrice is the price of TokenIn denominated in TokenOut
+	// realised by the swap, in display units.
+	ExecutionPrice *big.Rat
+	// PriceImpact is the percentage by which AmountOut falls short of the
+	// amount the spot price would yield, including the 0.3% fee.
+	PriceImpact *big.Rat
+}
+
+// FormatAmountIn renders AmountIn in display units, e.g. "1.5 WETH".
+func (q V2Quote) FormatAmountIn() string {
+	return q.TokenIn.Format(q.AmountIn) + " " + q.TokenIn.Symbol
+}
+
+// FormatAmountOut renders AmountOut in display units, e.g. "4685.17 USDC".
+func (q V2Quote) FormatAmountOut() string {
+	return q.TokenOut.Format(q.AmountOut) + " " + q.TokenOut.Symbol
+}
+
+// SpotPrice returns the pool's price of Token0 denominated in Token1,
+// in display units.
+func (p V2Pool) SpotPrice() (*big.Rat, error) {
+	if p.Reserve0.Sign() <= 0 || p.Reserve1.Sign() <= 0 {
+		return nil, ErrInsufficientLiquidity
+	}
+
+	price := new(big.Rat).SetFrac(p.Reserve1, p.Reserve0)
+	return price.Mul(price, p.decimalsScale()), nil
+}
+
+// QuoteExactIn quotes a swap of amountIn raw units of Token0 for Token1 if
+// zeroForOne is true, or of Token1 for Token0 otherwise.
+func (p V2Pool) QuoteExactIn(amountIn *big.Int, zeroForOne bool) (V2Quote, error) {
+	q := p.quote(zeroForOne)
+
+	amountOut, err := GetAmountOut(amountIn, q.reserveIn, q.reserveOut)
+	if err != nil {
+		return V2Quote{}, err
+	}
+
+	return q.finish(amountIn, amountOut), nil
+}
+
+// QuoteExactOut quotes a swap of Token0 for amountOut raw units of Token1 if
+// zeroForOne is true, or of Token1 for Token0 otherwise.
+func (p V2Pool) QuoteExactOut(amountOut *big.Int, zeroForOne bool) (V2Quote, error) {
+	q := p.quote(zeroForOne)
+
+	amountIn, err := GetAmountIn(amountOut, q.reserveIn, q.reserveOut)
+	if err != nil {
+		return V2Quote{}, err
+	}
+
+	return q.finish(amountIn, amountOut), nil
+}
+
+type v2Swap struct {
+	tokenIn, tokenOut     ethunits.Token
+	reserveIn, reserveOut *big.Int
+}
+
+func (p V2Pool) quote(zeroForOne bool) v2Swap {
+	if zeroForOne {
+		return v2Swap{tokenIn: p.Token0, tokenOut: p.Token1, reserveIn: p.Reserve0, reserveOut: p.Reserve1}
+	}
+
+	return v2Swap{tokenIn: p.Token1, tokenOut: p.Token0, reserveIn: p.Reserve1, reserveOut: p.Reserve0}
+}
+
+func (s v2Swap) finish(amountIn, amountOut *big.Int) V2Quote {
+	scale := decimalsScale(s.tokenIn.Decimals, s.tokenOut.Decimals)
+
+	spot := new(big.Rat).SetFrac(s.reserveOut, s.reserveIn)
+	execution := new(big.Rat).SetFrac(amountOut, amountIn)
+
+	// (spotOut - amountOut) / spotOut, where spotOut = amountIn * spot,
+	// simplifies to 1 - execution / spot.
+	impact := new(big.Rat).Quo(execution, spot)
+	impact.Sub(big.NewRat(1, 1), impact)
+	impact.Mul(impact, big.NewRat(100, 1))
+
+	return V2Quote{
+		TokenIn:        s.tokenIn,
+		TokenOut:       s.tokenOut,
+		AmountIn:       amountIn,
+		AmountOut:      amountOut,
+		SpotPrice:      spot.Mul(spot, scale),
+		ExecutionPrice: execution.Mul(execution, scale),
+		PriceImpact:    impact,
+	}
+}
@@ -0,0 +1,98 @@
+package uniswap_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jalavosus/go-ethunits/uniswap"
+)
+
+func TestGetAmountOut(t *testing.T) {
+	tests := []struct {
+		name                            string
+		amountIn, reserveIn, reserveOut *big.Int
+		want                            *big.Int
+		wantErr                         error
+	}{
+		{name: "basic", amountIn: big.NewInt(2), reserveIn: big.NewInt(100), reserveOut: big.NewInt(100), want: big.NewInt(1)},
+		{name: "rounds down", amountIn: big.NewInt(1000), reserveIn: big.NewInt(1_000_000), reserveOut: big.NewInt(1_000_000), want: big.NewInt(996)},
+		{name: "zero input", amountIn: big.NewInt(0), reserveIn: big.NewInt(100), reserveOut: big.NewInt(100), wantErr: uniswap.ErrInsufficientInputAmount},
+		{name: "empty pool", amountIn: big.NewInt(1), reserveIn: big.NewInt(0), reserveOut: big.NewInt(100), wantErr: uniswap.ErrInsufficientLiquidity},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := uniswap.GetAmountOut(tt.amountIn, tt.reserveIn, tt.reserveOut)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGetAmountIn(t *testing.T) {
+	tests := []struct {
+		name                             string
+		amountOut, reserveIn, reserveOut *big.Int
+		want                             *big.Int
+		wantErr                          error
+	}{
+		{name: "basic", amountOut: big.NewInt(1), reserveIn: big.NewInt(100), reserveOut: big.NewInt(100), want: big.NewInt(2)},
+		{name: "rounds up", amountOut: big.NewInt(996), reserveIn: big.NewInt(1_000_000), reserveOut: big.NewInt(1_000_000), want: big.NewInt(1000)},
+		{name: "zero output", amountOut: big.NewInt(0), reserveIn: big.NewInt(100), reserveOut: big.NewInt(100), wantErr: uniswap.ErrInsufficientOutputAmount},
+		{name: "drains pool", amountOut: big.NewInt(100), reserveIn: big.NewInt(100), reserveOut: big.NewInt(100), wantErr: uniswap.ErrInsufficientLiquidity},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := uniswap.GetAmountIn(tt.amountOut, tt.reserveIn, tt.reserveOut)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV2Pool_Quote(t *testing.T) {
+	pool := uniswap.V2Pool{
+		Pair:     uniswap.Pair{Token0: usdc, Token1: weth},
+		Reserve0: bigInt("10000000000000"),
+		Reserve1: bigInt("3200000000000000000000"),
+	}
+
+	spot, err := pool.SpotPrice()
+	require.NoError(t, err)
+	assert.Equal(t, "1/3125", spot.RatString())
+
+	quote, err := pool.QuoteExactIn(bigInt("1000000000000000000"), false)
+	require.NoError(t, err)
+	assert.Equal(t, "1 WETH", quote.FormatAmountIn())
+	assert.Equal(t, "3114.65459 USDC", quote.FormatAmountOut())
+	assert.Equal(t, "3125", quote.SpotPrice.RatString())
+	assert.Equal(t, "3114.65459", quote.ExecutionPrice.FloatString(5))
+	assert.Equal(t, "0.3311", quote.PriceImpact.FloatString(4))
+
+	quote, err = pool.QuoteExactOut(big.NewInt(3_000_000_000), false)
+	require.NoError(t, err)
+	assert.Equal(t, "0.963177619283779116 WETH", quote.FormatAmountIn())
+	assert.Equal(t, "3000 USDC", quote.FormatAmountOut())
+
+	quote, err = pool.QuoteExactIn(quote.AmountOut, true)
+	require.NoError(t, err)
+	assert.Equal(t, "USDC", quote.TokenIn.Symbol)
+	assert.Equal(t, "1/3125", quote.SpotPrice.RatString())
+
+	_, err = uniswap.V2Pool{Pair: pool.Pair, Reserve0: big.NewInt(0), Reserve1: big.NewInt(1)}.SpotPrice()
+	assert.ErrorIs(t, err, uniswap.ErrInsufficientLiquidity)
+}
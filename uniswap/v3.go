@@ -0,0 +1,69 @@
+package uniswap
+
+import (
+	"math/big"
+)
+
+var q192 = new(big.Int).Lsh(big.NewInt(1), 192)
+
+// PriceFromSqrtPriceX96 returns the exact price of Token0 denominated in
+// Token1, in display units, of a v3 pool with the given sqrtPriceX96.
+func (p Pair) PriceFromSqrtPriceX96(sqrtPriceX96 *big.Int) *big.Rat {
+	raw := new(big.Rat).SetFrac(new(big.Int).Mul(sqrtPriceX96, sqrtPriceX96), q192)
+	return raw.Mul(raw, p.decimalsScale())
+}
+
+// InversePriceFromSqrtPriceX96 returns the exact price of Token1 denominated
+// in Token0, in display units, of a v3 pool with the given sqrtPriceX96.
+func (p Pair) InversePriceFromSqrtPriceX96(sqrtPriceX96 *big.Int) (*big.Rat, error) {
+	return Invert(p.PriceFromSqrtPriceX96(sqrtPriceX96))
+}
+
+// PriceFromTick returns the exact price of Token0 denominated in Token1,
+// in display units, at the given tick.
+func (p Pair) PriceFromTick(tick int) (*big.Rat, error) {
+	sqrtPriceX96, err := SqrtRatioAtTick(tick)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.PriceFromSqrtPriceX96(sqrtPriceX96), nil
+}
+
+// SqrtPriceX96FromPrice returns the sqrtPriceX96 of a price of Token0
+// denominated in Token1, in display units, rounded down.
+func (p Pair) SqrtPriceX96FromPrice(price *big.Rat) (*big.Int, error) {
+	if price.Sign() <= 0 {
+		return nil, ErrInvalidPrice
+	}
+
+	raw := new(big.Rat).Quo(price, p.decimalsScale())
+
+	// floor(sqrt(x)) == floor(sqrt(floor(x))) for any x >= 0.
+	x := new(big.Int).Mul(raw.Num(), q192)
+	x.Quo(x, raw.Denom())
+
+	return x.Sqrt(x), nil
+}
+
+// SqrtPriceX96FromInversePrice returns the sqrtPriceX96 of a price of Token1
+// denominated in Token0, in display units, rounded down.
+func (p Pair) SqrtPriceX96FromInversePrice(price *big.Rat) (*big.Int, error) {
+	inverse, err := Invert(price)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.SqrtPriceX96FromPrice(inverse)
+}
+
+// TickFromPrice returns the greatest tick whose price of Token0 denominated in
+// Token1 is less than or equal to the given price, in display units.
+func (p Pair) TickFromPrice(price *big.Rat) (int, error) {
+	sqrtPriceX96, err := p.SqrtPriceX96FromPrice(price)
+	if err != nil {
+		return 0, err
+	}
+
+	return TickAtSqrtRatio(sqrtPriceX96)
+}
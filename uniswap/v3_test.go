@@ -0,0 +1,136 @@
+package uniswap_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jalavosus/go-ethunits"
+	"github.com/jalavosus/go-ethunits/uniswap"
+)
+
+var (
+	usdc = ethunits.Token{Symbol: "USDC", Decimals: 6}
+	weth = ethunits.Token{Symbol: "WETH", Decimals: 18}
+)
+
+func bigInt(s string) *big.Int {
+	b, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		panic("invalid big.Int " + s)
+	}
+
+	return b
+}
+
+func bigRat(s string) *big.Rat {
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		panic("invalid big.Rat " + s)
+	}
+
+	return r
+}
+
+func TestSqrtRatioAtTick(t *testing.T) {
+	tests := []struct {
+		name string
+		tick int
+		want *big.Int
+	}{
+		{name: "min tick", tick: uniswap.MinTick, want: uniswap.MinSqrtRatio},
+		{name: "max tick", tick: uniswap.MaxTick, want: uniswap.MaxSqrtRatio},
+		{name: "tick 0", tick: 0, want: new(big.Int).Lsh(big.NewInt(1), 96)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := uniswap.SqrtRatioAtTick(tt.tick)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := uniswap.SqrtRatioAtTick(uniswap.MaxTick + 1)
+	assert.ErrorIs(t, err, uniswap.ErrTickOutOfRange)
+
+	for _, tick := range []int{-500000, -50, -1, 1, 50, 100000, 500000} {
+		got, err := uniswap.SqrtRatioAtTick(tick)
+		require.NoError(t, err)
+
+		gotFloat, _ := new(big.Float).Quo(new(big.Float).SetInt(got), new(big.Float).SetInt(new(big.Int).Lsh(big.NewInt(1), 96))).Float64()
+		want := math.Pow(1.0001, float64(tick)/2)
+		assert.InEpsilonf(t, want, gotFloat, 1e-9, "tick %d", tick)
+	}
+}
+
+func TestTickAtSqrtRatio(t *testing.T) {
+	for _, tick := range []int{uniswap.MinTick, -195853, -1, 0, 1, 195853, uniswap.MaxTick - 1} {
+		ratio, err := uniswap.SqrtRatioAtTick(tick)
+		require.NoError(t, err)
+
+		got, err := uniswap.TickAtSqrtRatio(ratio)
+		require.NoError(t, err)
+		assert.Equal(t, tick, got)
+
+		got, err = uniswap.TickAtSqrtRatio(new(big.Int).Add(ratio, big.NewInt(1)))
+		require.NoError(t, err)
+		assert.Equal(t, tick, got)
+
+		if tick > uniswap.MinTick {
+			got, err = uniswap.TickAtSqrtRatio(new(big.Int).Sub(ratio, big.NewInt(1)))
+			require.NoError(t, err)
+			assert.Equal(t, tick-1, got)
+		}
+	}
+
+	_, err := uniswap.TickAtSqrtRatio(uniswap.MaxSqrtRatio)
+	assert.ErrorIs(t, err, uniswap.ErrSqrtPriceOutOfRange)
+
+	_, err = uniswap.TickAtSqrtRatio(new(big.Int).Sub(uniswap.MinSqrtRatio, big.NewInt(1)))
+	assert.ErrorIs(t, err, uniswap.ErrSqrtPriceOutOfRange)
+}
+
+func TestPair_Price(t *testing.T) {
+	pair := uniswap.Pair{Token0: usdc, Token1: weth}
+	wethPrice := bigRat("3123.45")
+
+	sqrtPriceX96, err := pair.SqrtPriceX96FromInversePrice(wethPrice)
+	require.NoError(t, err)
+	assert.Equal(t, bigInt("1417628072282004985984196781340145"), sqrtPriceX96)
+
+	inverse, err := pair.InversePriceFromSqrtPriceX96(sqrtPriceX96)
+	require.NoError(t, err)
+	assert.Equal(t, "1 WETH = 3123.45 USDC", pair.FormatInversePrice(inverse, 2))
+
+	price := pair.PriceFromSqrtPriceX96(sqrtPriceX96)
+	assert.Equal(t, "1 USDC = 0.000320159 WETH", pair.FormatPrice(price, 9))
+
+	tick, err := pair.TickFromPrice(price)
+	require.NoError(t, err)
+	assert.Equal(t, 195853, tick)
+
+	tickPrice, err := pair.PriceFromTick(tick)
+	require.NoError(t, err)
+	assert.True(t, tickPrice.Cmp(price) <= 0)
+
+	nextPrice, err := pair.PriceFromTick(tick + 1)
+	require.NoError(t, err)
+	assert.True(t, nextPrice.Cmp(price) > 0)
+
+	_, err = pair.SqrtPriceX96FromPrice(new(big.Rat))
+	assert.ErrorIs(t, err, uniswap.ErrInvalidPrice)
+}
+
+func TestPair_Price_Decimals(t *testing.T) {
+	pair := uniswap.Pair{Token0: ethunits.Token{Symbol: "DAI", Decimals: 18}, Token1: usdc}
+
+	price := pair.PriceFromSqrtPriceX96(bigInt("79228162514264337593543"))
+	assert.Equal(t, "1.000000", price.FloatString(6))
+
+	price = uniswap.Pair{Token0: weth, Token1: weth}.PriceFromSqrtPriceX96(new(big.Int).Lsh(big.NewInt(1), 96))
+	assert.Equal(t, "1", price.RatString())
+}
@@ -1,5 +1,9 @@
 package ethunits
 
+import (
+	"strings"
+)
+
 //go:generate stringer -type Unit
 
 type Unit uint
@@ -71,4 +75,58 @@ func UnitFromDecimals[T DecimalValue](decimals T) (Unit, bool) {
 	}
 
 	return u, ok
-}
\ No newline at end of file
+}
+
+// WeiExponent returns the power of ten which converts an amount of u
+// into Wei, e.g. 9 for GWei and 18 for Ether.
+func (u Unit) WeiExponent() (int, bool) {
+	if u == Ether {
+		return 18, true
+	}
+
+	decimals, ok := unitDecimalsMap[u]
+	if !ok {
+		return 0, false
+	}
+
+	return 18 - decimals, true
+}
+
+var unitAliases = map[string]Unit{
+	"wei":        Wei,
+	"kwei":       KWei,
+	"babbage":    KWei,
+	"femtoether": KWei,
+	"mwei":       MWei,
+	"lovelace":   MWei,
+	"picoether":  MWei,
+	"gwei":       GWei,
+	"shannon":    GWei,
+	"nanoether":  GWei,
+	"nano":       GWei,
+	"szabo":      Szabo,
+	"microether": Szabo,
+	"micro":      Szabo,
+	"finney":     Finney,
+	"milliether": Finney,
+	"milli":      Finney,
+	"ether":      Ether,
+	"eth":        Ether,
+}
+
+// Units returns every known Unit, from Wei to Ether.
+func Units() []Unit {
+	return []Unit{Wei, KWei, MWei, GWei, Szabo, Finney, Ether}
+}
+
+// ParseUnit returns the Unit with the given name, ignoring case.
+// Besides the names of the Unit constants it accepts the usual aliases,
+// such as "shannon" for GWei and "eth" for Ether.
+func ParseUnit(name string) (Unit, bool) {
+	u, ok := unitAliases[strings.ToLower(strings.TrimSpace(name))]
+	if !ok {
+		return Unknown, false
+	}
+
+	return u, true
+}
@@ -0,0 +1,147 @@
+// Package vesting computes vested and releasable token amounts,
+// rounding exactly like OpenZeppelin's VestingWallet contracts.
+package vesting
+
+import (
+	"errors"
+	"math"
+	"math/big"
+)
+
+var (
+	ErrInvalidCliff    = errors.New("vesting: cliff must not exceed duration")
+	ErrInvalidStep     = errors.New("vesting: step duration and step count must be positive")
+	ErrInvalidInterval = errors.New("vesting: interval must be positive")
+	ErrOverReleased    = errors.New("vesting: released exceeds vested amount")
+)
+
+// Schedule determines how much of an allocation is vested at a Unix timestamp.
+type Schedule interface {
+	// Start returns the timestamp vesting starts at.
+	Start() uint64
+	// End returns the timestamp the whole allocation is vested at.
+	End() uint64
+	// Vested returns the amount of totalAllocation vested at timestamp.
+	Vested(totalAllocation *big.Int, timestamp uint64) *big.Int
+}
+
+// Linear vests an allocation linearly from its start over its duration,
+// like VestingWallet.
+type Linear struct {
+	StartTime uint64
+	Duration  uint64
+}
+
+// NewLinear returns a Linear schedule.
+func NewLinear(start, duration uint64) Linear {
+	return Linear{StartTime: start, Duration: duration}
+}
+
+// Start implements VestingWallet.start.
+func (l Linear) Start() uint64 {
+	return l.StartTime
+}
+
+// End implements VestingWallet.end. It saturates at math.MaxUint64 rather
+// than wrapping around.
+func (l Linear) End() uint64 {
+	return addSat(l.StartTime, l.Duration)
+}
+
+// Vested implements VestingWallet._vestingSchedule.
+func (l Linear) Vested(totalAllocation *big.Int, timestamp uint64) *big.Int {
+	switch {
+	case timestamp < l.Start():
+		return new(big.Int)
+	case timestamp >= l.End():
+		return new(big.Int).Set(totalAllocation)
+	}
+
+	vested := new(big.Int).Mul(totalAllocation, new(big.Int).SetUint64(timestamp-l.StartTime))
+	return vested.Quo(vested, new(big.Int).SetUint64(l.Duration))
+}
+
+// Cliff vests nothing until its cliff, then vests like Linear,
+// like VestingWalletCliff.
+type Cliff struct {
+	Linear
+	CliffDuration uint64
+}
+
+// NewCliff returns a Cliff schedule whose cliff is cliffDuration seconds after start.
+func NewCliff(start, cliffDuration, duration uint64) (Cliff, error) {
+	if cliffDuration > duration {
+		return Cliff{}, ErrInvalidCliff
+	}
+
+	return Cliff{Linear: NewLinear(start, duration), CliffDuration: cliffDuration}, nil
+}
+
+// Cliff returns the timestamp of the cliff.
+func (c Cliff) Cliff() uint64 {
+	return addSat(c.StartTime, c.CliffDuration)
+}
+
+// Vested implements VestingWalletCliff._vestingSchedule.
+func (c Cliff) Vested(totalAllocation *big.Int, timestamp uint64) *big.Int {
+	if timestamp < c.Cliff() {
+		return new(big.Int)
+	}
+
+	return c.Linear.Vested(totalAllocation, timestamp)
+}
+
+// Step vests an allocation in equal tranches, one at the end of each step.
+type Step struct {
+	StartTime    uint64
+	StepDuration uint64
+	Steps        uint64
+}
+
+// NewStep returns a Step schedule.
+func NewStep(start, stepDuration, steps uint64) (Step, error) {
+	if stepDuration == 0 || steps == 0 {
+		return Step{}, ErrInvalidStep
+	}
+
+	return Step{StartTime: start, StepDuration: stepDuration, Steps: steps}, nil
+}
+
+// Start returns the timestamp the first step begins at.
+func (s Step) Start() uint64 {
+	return s.StartTime
+}
+
+// End returns the timestamp the last tranche vests at. It saturates at
+// math.MaxUint64 rather than wrapping around.
+func (s Step) End() uint64 {
+	if s.Steps != 0 && s.StepDuration > math.MaxUint64/s.Steps {
+		return math.MaxUint64
+	}
+
+	return addSat(s.StartTime, s.StepDuration*s.Steps)
+}
+
+// Vested returns totalAllocation * completedSteps / steps, rounded down.
+func (s Step) Vested(totalAllocation *big.Int, timestamp uint64) *big.Int {
+	switch {
+	case timestamp < s.Start():
+		return new(big.Int)
+	case timestamp >= s.End():
+		return new(big.Int).Set(totalAllocation)
+	}
+
+	completed := (timestamp - s.StartTime) / s.StepDuration
+
+	vested := new(big.Int).Mul(totalAllocation, new(big.Int).SetUint64(completed))
+	return vested.Quo(vested, new(big.Int).SetUint64(s.Steps))
+}
+
+// addSat returns a + b, or math.MaxUint64 if the sum overflows.
+func addSat(a, b uint64) uint64 {
+	if a > math.MaxUint64-b {
+		return math.MaxUint64
+	}
+
+	return a + b
+}
@@ -0,0 +1,107 @@
+package vesting_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jalavosus/go-ethunits/vesting"
+)
+
+func TestSchedules(t *testing.T) {
+	cliff, err := vesting.NewCliff(1000, 250, 1000)
+	require.NoError(t, err)
+
+	step, err := vesting.NewStep(1000, 250, 4)
+	require.NoError(t, err)
+
+	longStep, err := vesting.NewStep(1000, 1<<62, 8)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		schedule  vesting.Schedule
+		timestamp uint64
+		want      int64
+	}{
+		{name: "linear,before start", schedule: vesting.NewLinear(1000, 3), timestamp: 999, want: 0},
+		{name: "linear,at start", schedule: vesting.NewLinear(1000, 3), timestamp: 1000, want: 0},
+		{name: "linear,rounds down", schedule: vesting.NewLinear(1000, 3), timestamp: 1001, want: 3},
+		{name: "linear,rounds down again", schedule: vesting.NewLinear(1000, 3), timestamp: 1002, want: 6},
+		{name: "linear,at end", schedule: vesting.NewLinear(1000, 3), timestamp: 1003, want: 10},
+		{name: "linear,zero duration", schedule: vesting.NewLinear(1000, 0), timestamp: 1000, want: 10},
+		{name: "cliff,before cliff", schedule: cliff, timestamp: 1249, want: 0},
+		{name: "cliff,at cliff", schedule: cliff, timestamp: 1250, want: 2},
+		{name: "cliff,after cliff", schedule: cliff, timestamp: 1999, want: 9},
+		{name: "step,within first step", schedule: step, timestamp: 1249, want: 0},
+		{name: "step,first step", schedule: step, timestamp: 1250, want: 2},
+		{name: "step,third step", schedule: step, timestamp: 1750, want: 7},
+		{name: "step,end", schedule: step, timestamp: 2000, want: 10},
+		{name: "linear,end overflows", schedule: vesting.NewLinear(math.MaxUint64-10, 20), timestamp: math.MaxUint64 - 1, want: 4},
+		{name: "step,end overflows", schedule: longStep, timestamp: 1000 + 1<<62, want: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, big.NewInt(tt.want), tt.schedule.Vested(big.NewInt(10), tt.timestamp))
+		})
+	}
+
+	assert.Equal(t, uint64(math.MaxUint64), vesting.NewLinear(math.MaxUint64-10, 20).End())
+	assert.Equal(t, uint64(math.MaxUint64), longStep.End())
+
+	_, err = vesting.NewCliff(0, 2, 1)
+	assert.ErrorIs(t, err, vesting.ErrInvalidCliff)
+
+	_, err = vesting.NewStep(0, 0, 1)
+	assert.ErrorIs(t, err, vesting.ErrInvalidStep)
+}
+
+func TestWallet(t *testing.T) {
+	balance, _ := new(big.Int).SetString("750000000000000000000000", 10)
+	released, _ := new(big.Int).SetString("250000000000000000000000", 10)
+
+	w := vesting.Wallet{
+		Schedule: vesting.NewLinear(1_700_000_000, 365*24*60*60),
+		Balance:  balance,
+		Released: released,
+		Decimals: 18,
+	}
+
+	assert.Equal(t, "1000000000000000000000000", w.TotalAllocation().String())
+
+	releasable, err := w.Releasable(1_700_000_000 + 365*24*60*60/2)
+	require.NoError(t, err)
+	assert.Equal(t, "250000000000000000000000", releasable.String())
+
+	releasable, err = w.Releasable(1_700_000_000 + 1)
+	assert.ErrorIs(t, err, vesting.ErrOverReleased)
+	assert.Nil(t, releasable)
+
+	table, err := w.Table(100 * 24 * 60 * 60)
+	require.NoError(t, err)
+	require.Len(t, table, 5)
+
+	want := []struct {
+		vested, unvested string
+	}{
+		{vested: "0", unvested: "1000000"},
+		{vested: "273972.60273972602739726", unvested: "726027.39726027397260274"},
+		{vested: "547945.20547945205479452", unvested: "452054.79452054794520548"},
+		{vested: "821917.80821917808219178", unvested: "178082.19178082191780822"},
+		{vested: "1000000", unvested: "0"},
+	}
+
+	for i, entry := range table {
+		assert.Equal(t, want[i].vested, entry.FormatVested())
+		assert.Equal(t, want[i].unvested, entry.FormatUnvested())
+	}
+
+	assert.Equal(t, w.Schedule.End(), table[len(table)-1].Timestamp)
+
+	_, err = w.Table(0)
+	assert.ErrorIs(t, err, vesting.ErrInvalidInterval)
+}
@@ -0,0 +1,101 @@
+package vesting
+
+import (
+	"math/big"
+
+	"github.com/jalavosus/go-ethunits"
+)
+
+// Wallet is a snapshot of a vesting wallet holding a single token.
+// Amounts are raw token units.
+type Wallet struct {
+	Schedule Schedule
+	// Balance is the wallet's current token balance.
+	Balance *big.Int
+	// Released is the amount already released to the beneficiary.
+	Released *big.Int
+	// Decimals are the decimals of the token, used for formatting.
+	Decimals uint8
+}
+
+// Entry is one row of a vesting table.
+type Entry struct {
+	Timestamp uint64
+	Vested    *big.Int
+	Unvested  *big.Int
+	Decimals  uint8
+}
+
+// FormatVested renders the vested amount in display units.
+func (e Entry) FormatVested() string {
+	return ethunits.FormatUnits(e.Vested, e.Decimals)
+}
+
+// FormatUnvested renders the unvested amount in display units.
+func (e Entry) FormatUnvested() string {
+	return ethunits.FormatUnits(e.Unvested, e.Decimals)
+}
+
+// TotalAllocation returns the wallet's balance plus the amount already
+// released, which is what VestingWallet vests.
+func (w Wallet) TotalAllocation() *big.Int {
+	total := new(big.Int)
+	if w.Balance != nil {
+		total.Add(total, w.Balance)
+	}
+
+	if w.Released != nil {
+		total.Add(total, w.Released)
+	}
+
+	return total
+}
+
+// Vested returns the amount vested at timestamp, like VestingWallet.vestedAmount.
+func (w Wallet) Vested(timestamp uint64) *big.Int {
+	return w.Schedule.Vested(w.TotalAllocation(), timestamp)
+}
+
+// Releasable returns the amount releasable at timestamp, like VestingWallet.releasable.
+func (w Wallet) Releasable(timestamp uint64) (*big.Int, error) {
+	releasable := w.Vested(timestamp)
+	if w.Released != nil {
+		releasable.Sub(releasable, w.Released)
+	}
+
+	if releasable.Sign() < 0 {
+		return nil, ErrOverReleased
+	}
+
+	return releasable, nil
+}
+
+// Table returns the vested and unvested amounts every interval seconds from
+// the start of the schedule until its end, which is always the last entry.
+func (w Wallet) Table(interval uint64) ([]Entry, error) {
+	if interval == 0 {
+		return nil, ErrInvalidInterval
+	}
+
+	total := w.TotalAllocation()
+	start, end := w.Schedule.Start(), w.Schedule.End()
+
+	var table []Entry
+	for ts := start; ; ts += interval {
+		if ts > end || ts < start {
+			ts = end
+		}
+
+		vested := w.Schedule.Vested(total, ts)
+		table = append(table, Entry{
+			Timestamp: ts,
+			Vested:    vested,
+			Unvested:  new(big.Int).Sub(total, vested),
+			Decimals:  w.Decimals,
+		})
+
+		if ts == end {
+			return table, nil
+		}
+	}
+}
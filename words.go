@@ -0,0 +1,152 @@
+package ethunits
+
+import (
+	"math/big"
+	"strings"
+)
+
+var (
+	smallNumberWords = []string{
+		"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine",
+		"ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen",
+		"seventeen", "eighteen", "nineteen",
+	}
+
+	tensWords = []string{
+		"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety",
+	}
+
+	// scaleWords are the short scale names of successive powers of 1000.
+	scaleWords = []string{
+		"", "thousand", "million", "billion", "trillion", "quadrillion",
+		"quintillion", "sextillion", "septillion", "octillion", "nonillion",
+		"decillion", "undecillion", "duodecillion", "tredecillion",
+		"quattuordecillion", "quindecillion", "sexdecillion", "septendecillion",
+		"octodecillion", "novemdecillion", "vigintillion", "unvigintillion",
+		"duovigintillion", "trevigintillion", "quattuorvigintillion",
+	}
+)
+
+// unitWords holds the singular and plural English names of each unit.
+var unitWords = map[Unit][2]string{
+	Wei:    {"wei", "wei"},
+	KWei:   {"kwei", "kwei"},
+	MWei:   {"mwei", "mwei"},
+	GWei:   {"gwei", "gwei"},
+	Szabo:  {"szabo", "szabos"},
+	Finney: {"finney", "finneys"},
+	Ether:  {"ether", "ether"},
+}
+
+// SpellUnits spells out a raw amount of a currency with the given number of
+// decimals in English words, e.g. "one point five" for 1500000 with
+// 6 decimals. Fractional digits are read one by one after "point",
+// and negative amounts start with "minus".
+func SpellUnits[T DecimalValue](amount *big.Int, decimals T) string {
+	s := FormatUnits(amount, decimals)
+
+	var words []string
+	if strings.HasPrefix(s, "-") {
+		words = append(words, "minus")
+		s = s[1:]
+	}
+
+	whole, frac, hasFrac := strings.Cut(s, ".")
+
+	n, _ := new(big.Int).SetString(whole, 10)
+	words = append(words, spellInteger(n))
+
+	if hasFrac {
+		words = append(words, "point")
+		for _, d := range frac {
+			words = append(words, smallNumberWords[d-'0'])
+		}
+	}
+
+	return strings.Join(words, " ")
+}
+
+// SpellWei spells out an amount of Wei in u in English words, followed by
+// the unit's name, e.g. "one point five ether" or "twenty-one gwei".
+// It returns false if u is unknown.
+func SpellWei(wei *big.Int, u Unit) (string, bool) {
+	exp, ok := u.WeiExponent()
+	if !ok {
+		return "", false
+	}
+
+	names := unitWords[u]
+
+	name := names[1]
+	if new(big.Int).Abs(wei).Cmp(pow10Int(exp)) == 0 {
+		name = names[0]
+	}
+
+	return SpellUnits(wei, exp) + " " + name, true
+}
+
+// spellInteger spells out a non-negative integer.
+func spellInteger(n *big.Int) string {
+	if n.Sign() == 0 {
+		return smallNumberWords[0]
+	}
+
+	// Amounts beyond the largest named scale repeat it,
+	// e.g. "one thousand quattuorvigintillion".
+	largest := pow10Int(3 * (len(scaleWords) - 1))
+	if n.Cmp(new(big.Int).Mul(largest, big.NewInt(1000))) >= 0 {
+		high, low := new(big.Int).QuoRem(n, largest, new(big.Int))
+
+		s := spellInteger(high) + " " + scaleWords[len(scaleWords)-1]
+		if low.Sign() > 0 {
+			s += " " + spellInteger(low)
+		}
+
+		return s
+	}
+
+	var (
+		groups   []string
+		thousand = big.NewInt(1000)
+		rem      = new(big.Int)
+		rest     = new(big.Int).Set(n)
+	)
+
+	for scale := 0; rest.Sign() > 0; scale++ {
+		rest.QuoRem(rest, thousand, rem)
+		if rem.Sign() == 0 {
+			continue
+		}
+
+		group := spellHundreds(int(rem.Int64()))
+		if scaleWords[scale] != "" {
+			group += " " + scaleWords[scale]
+		}
+
+		groups = append([]string{group}, groups...)
+	}
+
+	return strings.Join(groups, " ")
+}
+
+// spellHundreds spells out an integer between 1 and 999.
+func spellHundreds(n int) string {
+	var words []string
+
+	if n >= 100 {
+		words = append(words, smallNumberWords[n/100], "hundred")
+		n %= 100
+	}
+
+	switch {
+	case n == 0:
+	case n < 20:
+		words = append(words, smallNumberWords[n])
+	case n%10 == 0:
+		words = append(words, tensWords[n/10])
+	default:
+		words = append(words, tensWords[n/10]+"-"+smallNumberWords[n%10])
+	}
+
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,93 @@
+package ethunits_test
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jalavosus/go-ethunits"
+)
+
+func TestSpellWei(t *testing.T) {
+	tests := []struct {
+		name string
+		wei  string
+		unit ethunits.Unit
+		want string
+	}{
+		{name: "one point five ether", wei: "1500000000000000000", unit: ethunits.Ether, want: "one point five ether"},
+		{name: "twenty-one gwei", wei: "21000000000", unit: ethunits.GWei, want: "twenty-one gwei"},
+		{name: "singular", wei: "1000000000000000", unit: ethunits.Finney, want: "one finney"},
+		{name: "negative singular", wei: "-1000000000000000", unit: ethunits.Finney, want: "minus one finney"},
+		{name: "plural", wei: "2000000000000000", unit: ethunits.Finney, want: "two finneys"},
+		{name: "fractional plural", wei: "1500000000000", unit: ethunits.Szabo, want: "one point five szabos"},
+		{name: "zero", wei: "0", unit: ethunits.Szabo, want: "zero szabos"},
+		{name: "leading fractional zeros", wei: "50000000000000000", unit: ethunits.Ether, want: "zero point zero five ether"},
+		{name: "hundreds", wei: wantWeiStr, unit: ethunits.Ether, want: "three hundred forty-two point five ether"},
+		{name: "round scale", wei: "1000000000000000000000000", unit: ethunits.Ether, want: "one million ether"},
+		{
+			name: "groups",
+			wei:  "1002003040",
+			unit: ethunits.Wei,
+			want: "one billion two million three thousand forty wei",
+		},
+		{
+			name: "max uint256",
+			wei:  "115792089237316195423570985008687907853269984665640564039457584007913129639935",
+			unit: ethunits.Wei,
+			want: "one hundred fifteen quattuorvigintillion seven hundred ninety-two trevigintillion " +
+				"eighty-nine duovigintillion two hundred thirty-seven unvigintillion " +
+				"three hundred sixteen vigintillion one hundred ninety-five novemdecillion " +
+				"four hundred twenty-three octodecillion five hundred seventy septendecillion " +
+				"nine hundred eighty-five sexdecillion eight quindecillion " +
+				"six hundred eighty-seven quattuordecillion nine hundred seven tredecillion " +
+				"eight hundred fifty-three duodecillion two hundred sixty-nine undecillion " +
+				"nine hundred eighty-four decillion six hundred sixty-five nonillion " +
+				"six hundred forty octillion five hundred sixty-four septillion " +
+				"thirty-nine sextillion four hundred fifty-seven quintillion " +
+				"five hundred eighty-four quadrillion seven trillion nine hundred thirteen billion " +
+				"one hundred twenty-nine million six hundred thirty-nine thousand " +
+				"nine hundred thirty-five wei",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ethunits.SpellWei(makeBigInt(tt.wei), tt.unit)
+			require.True(t, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, ok := ethunits.SpellWei(big.NewInt(1), ethunits.Unknown)
+	assert.False(t, ok)
+}
+
+func TestSpellUnits(t *testing.T) {
+	tests := []struct {
+		amount   int64
+		decimals int
+		want     string
+	}{
+		{amount: 1500000, decimals: 6, want: "one point five"},
+		{amount: 13, decimals: 0, want: "thirteen"},
+		{amount: 90, decimals: 0, want: "ninety"},
+		{amount: 100, decimals: 0, want: "one hundred"},
+		{amount: 1000001, decimals: 0, want: "one million one"},
+		{amount: -1234, decimals: 2, want: "minus twelve point three four"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, ethunits.SpellUnits(big.NewInt(tt.amount), tt.decimals))
+	}
+
+	// Beyond the largest named scale, quattuorvigintillion repeats.
+	huge := new(big.Int).Exp(big.NewInt(10), big.NewInt(81), nil)
+	assert.Equal(t, "one million quattuorvigintillion", ethunits.SpellUnits(huge, 0))
+
+	huge.Add(huge, big.NewInt(2))
+	assert.True(t, strings.HasSuffix(ethunits.SpellUnits(huge, 0), "quattuorvigintillion two"))
+}
@@ -0,0 +1,55 @@
+package ethunits
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// MarshalYAML encodes u as its lowercase name, e.g. "gwei".
+func (u Unit) MarshalYAML() (any, error) {
+	if _, ok := u.WeiExponent(); !ok {
+		return nil, fmt.Errorf("%w %v", ErrUnknownUnit, u)
+	}
+
+	return unitName(u), nil
+}
+
+// UnmarshalYAML decodes a unit name, as accepted by ParseUnit.
+func (u *Unit) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind != yaml.ScalarNode {
+		return fmt.Errorf("line %d: %w: want a unit name", value.Line, ErrUnknownUnit)
+	}
+
+	if err := u.Set(value.Value); err != nil {
+		return fmt.Errorf("line %d: %w", value.Line, err)
+	}
+
+	return nil
+}
+
+// MarshalYAML encodes a as a string in its unit, e.g. "0.5 ether".
+func (a Amount) MarshalYAML() (any, error) {
+	return a.String(), nil
+}
+
+// UnmarshalYAML decodes an amount such as "0.5 ether", or a plain number
+// such as 500000000000000000. Amounts with a unit suffix are displayed in
+// that unit afterwards, so marshalled amounts round-trip, and hexadecimal
+// amounts in wei. Plain numbers are read in the unit a holds before
+// decoding, which is Wei for the zero Amount; set a.Unit to declare the
+// default unit of a field.
+func (a *Amount) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind != yaml.ScalarNode {
+		return fmt.Errorf("line %d: %w: want a number with an optional unit", value.Line, ErrInvalidAmount)
+	}
+
+	wei, u, err := parseAmount(value.Value, a.Unit)
+	if err != nil {
+		return fmt.Errorf("line %d: %w", value.Line, err)
+	}
+
+	a.Wei, a.Unit = wei, u
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package ethunits_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/jalavosus/go-ethunits"
+)
+
+type limitsConfig struct {
+	MinBalance  ethunits.Amount `yaml:"min_balance"`
+	MaxGasPrice ethunits.Amount `yaml:"max_gas_price"`
+	Display     ethunits.Unit   `yaml:"display"`
+}
+
+func TestYAML(t *testing.T) {
+	input := []byte("min_balance: 0.5 ether\nmax_gas_price: 150\ndisplay: Shannon\n")
+
+	// Fields declare the unit plain numbers are read in.
+	cfg := limitsConfig{
+		MinBalance:  ethunits.Amount{Unit: ethunits.Ether},
+		MaxGasPrice: ethunits.Amount{Unit: ethunits.GWei},
+	}
+	require.NoError(t, yaml.Unmarshal(input, &cfg))
+
+	assertBigIntEqual(t, makeBigInt("500000000000000000"), cfg.MinBalance.Wei)
+	assertBigIntEqual(t, makeBigInt("150000000000"), cfg.MaxGasPrice.Wei)
+	assert.Equal(t, ethunits.GWei, cfg.Display)
+
+	out, err := yaml.Marshal(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, "min_balance: 0.5 ether\nmax_gas_price: 150 gwei\ndisplay: gwei\n", string(out))
+
+	var raw limitsConfig
+	require.NoError(t, yaml.Unmarshal([]byte("min_balance: 500000000000000000\nmax_gas_price: 0x22ecb25c00\n"), &raw))
+	assert.Equal(t, "500000000000000000 wei", raw.MinBalance.String())
+	assert.Equal(t, "150000000000 wei", raw.MaxGasPrice.String())
+
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{name: "unknown unit", input: "min_balance: 1\nmax_gas_price: 5 dogecoin\n", wantErr: `line 2: unknown unit "dogecoin" in "5 dogecoin"`},
+		{name: "not a scalar", input: "min_balance: [1, 2]\n", wantErr: "line 1: invalid amount: want a number with an optional unit"},
+		{name: "unknown display unit", input: "display: satoshi\n", wantErr: `line 1: unknown unit "satoshi": want one of wei, kwei, mwei, gwei, szabo, finney, ether`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var cfg limitsConfig
+			err := yaml.Unmarshal([]byte(tt.input), &cfg)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+
+	// Units given in the document replace the fields' default units.
+	var roundTrip limitsConfig
+	require.NoError(t, yaml.Unmarshal(out, &roundTrip))
+	assert.Equal(t, cfg, roundTrip)
+
+	var suffixed limitsConfig
+	require.NoError(t, yaml.Unmarshal([]byte("min_balance: 1500 finney\n"), &suffixed))
+	assert.Equal(t, ethunits.Finney, suffixed.MinBalance.Unit)
+	assert.Equal(t, "1500 finney", suffixed.MinBalance.String())
+
+	_, err = yaml.Marshal(struct{ U ethunits.Unit }{U: ethunits.Unknown})
+	assert.ErrorIs(t, err, ethunits.ErrUnknownUnit)
+}